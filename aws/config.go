@@ -1,14 +1,26 @@
 package aws
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
@@ -158,8 +170,16 @@ import (
 	"github.com/aws/aws-sdk-go/service/workspaces"
 	"github.com/aws/aws-sdk-go/service/xray"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/apilog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/endpointresolver"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/limiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/retryer"
+	"golang.org/x/net/http/httpproxy"
 )
 
 type Config struct {
@@ -171,6 +191,10 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
+	RetryMode            string
+	ServiceRequestLimits map[string]limiter.Limit
+	ServiceRetryPolicies map[string]retryer.Policy
+
 	AssumeRoleARN               string
 	AssumeRoleDurationSeconds   int
 	AssumeRoleExternalID        string
@@ -180,12 +204,30 @@ type Config struct {
 	AssumeRoleTags              map[string]string
 	AssumeRoleTransitiveTagKeys []string
 
+	// AssumeRoleChain lists roles assumed in order before the AssumeRole* role above.
+	AssumeRoleChain []AssumeRole
+
+	AssumeRoleWithWebIdentityARN             string
+	AssumeRoleWithWebIdentityDurationSeconds int
+	AssumeRoleWithWebIdentityPolicy          string
+	AssumeRoleWithWebIdentityPolicyARNs      []string
+	AssumeRoleWithWebIdentitySessionName     string
+	AssumeRoleWithWebIdentityToken           string
+	AssumeRoleWithWebIdentityTokenFile       string
+
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
-	Endpoints        map[string]string
-	IgnoreTagsConfig *keyvaluetags.IgnoreConfig
-	Insecure         bool
+	APILogFile         string
+	CustomCABundle     string
+	DefaultTagsConfig  *keyvaluetags.DefaultConfig
+	Endpoints          map[string]string
+	HTTPProxy          string
+	HTTPSProxy         string
+	IgnoreTagsConfig   *keyvaluetags.IgnoreConfig
+	Insecure           bool
+	NoProxy            string
+	RequiredTagsConfig *keyvaluetags.RequiredConfig
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -193,10 +235,24 @@ type Config struct {
 	SkipRequestingAccountId bool
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
+	UseDualStackEndpoint    bool
+	UseFIPSEndpoint         bool
 
 	terraformVersion string
 }
 
+// AssumeRole describes a single role assumption in an assume_role chain.
+type AssumeRole struct {
+	ARN               string
+	DurationSeconds   int
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	SessionName       string
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
 type AWSClient struct {
 	accessanalyzerconn                  *accessanalyzer.AccessAnalyzer
 	accountid                           string
@@ -240,6 +296,7 @@ type AWSClient struct {
 	datapipelineconn                    *datapipeline.DataPipeline
 	datasyncconn                        *datasync.DataSync
 	daxconn                             *dax.DAX
+	DefaultTagsConfig                   *keyvaluetags.DefaultConfig
 	devicefarmconn                      *devicefarm.DeviceFarm
 	dlmconn                             *dlm.DLM
 	dmsconn                             *databasemigrationservice.DatabaseMigrationService
@@ -317,6 +374,7 @@ type AWSClient struct {
 	rdsconn                             *rds.RDS
 	redshiftconn                        *redshift.Redshift
 	region                              string
+	RequiredTagsConfig                  *keyvaluetags.RequiredConfig
 	resourcegroupsconn                  *resourcegroups.ResourceGroups
 	resourcegroupstaggingapiconn        *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
 	route53domainsconn                  *route53domains.Route53Domains
@@ -371,6 +429,385 @@ func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.region, client.dnsSuffix)
 }
 
+// httpClient returns the HTTP client shared by the provider session and all
+// requests made while configuring its credentials.
+func (c *Config) httpClient() (*http.Client, error) {
+	client := cleanhttp.DefaultClient()
+
+	if c.Insecure {
+		transport := client.Transport.(*http.Transport)
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true,
+		}
+	}
+
+	if err := c.configureHTTPClient(client); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// configureHTTPClient applies the custom CA bundle and proxy settings to the
+// given HTTP client. Service clients copied from a session share its HTTP client.
+func (c *Config) configureHTTPClient(client *http.Client) error {
+	if c.CustomCABundle == "" && c.HTTPProxy == "" && c.HTTPSProxy == "" && c.NoProxy == "" {
+		return nil
+	}
+
+	transport, ok := client.Transport.(*http.Transport)
+
+	if !ok {
+		return fmt.Errorf("unable to configure HTTP client: unexpected transport type (%T)", client.Transport)
+	}
+
+	if c.CustomCABundle != "" {
+		filename, err := homedir.Expand(c.CustomCABundle)
+
+		if err != nil {
+			return fmt.Errorf("error expanding custom CA bundle (%s): %w", c.CustomCABundle, err)
+		}
+
+		b, err := ioutil.ReadFile(filename)
+
+		if err != nil {
+			return fmt.Errorf("error reading custom CA bundle (%s): %w", filename, err)
+		}
+
+		certPool := x509.NewCertPool()
+
+		if !certPool.AppendCertsFromPEM(b) {
+			return fmt.Errorf("error reading custom CA bundle (%s): no PEM encoded certificates found", filename)
+		}
+
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+
+		transport.TLSClientConfig.RootCAs = certPool
+	}
+
+	proxyConfig := httpproxy.FromEnvironment()
+
+	if c.HTTPProxy != "" {
+		proxyConfig.HTTPProxy = c.HTTPProxy
+	}
+
+	if c.HTTPSProxy != "" {
+		proxyConfig.HTTPSProxy = c.HTTPSProxy
+	}
+
+	if c.NoProxy != "" {
+		proxyConfig.NoProxy = c.NoProxy
+	}
+
+	proxyFunc := proxyConfig.ProxyFunc()
+	transport.Proxy = func(r *http.Request) (*url.URL, error) {
+		return proxyFunc(r.URL)
+	}
+
+	return nil
+}
+
+// assumeRoleWithWebIdentity exchanges the configured OpenID Connect token for
+// temporary credentials of the configured IAM Role. The request is unsigned,
+// so no pre-existing credentials are required.
+func (c *Config) assumeRoleWithWebIdentity(httpClient *http.Client) (*sts.Credentials, error) {
+	token := c.AssumeRoleWithWebIdentityToken
+
+	if token == "" && c.AssumeRoleWithWebIdentityTokenFile != "" {
+		filename, err := homedir.Expand(c.AssumeRoleWithWebIdentityTokenFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding web identity token file (%s): %w", c.AssumeRoleWithWebIdentityTokenFile, err)
+		}
+
+		b, err := ioutil.ReadFile(filename)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading web identity token file (%s): %w", filename, err)
+		}
+
+		token = strings.TrimSpace(string(b))
+	}
+
+	if token == "" {
+		return nil, fmt.Errorf("assume role with web identity (%s): one of web_identity_token or web_identity_token_file must be set", c.AssumeRoleWithWebIdentityARN)
+	}
+
+	awsConfig := &aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Endpoint:    aws.String(c.Endpoints["sts"]),
+		HTTPClient:  httpClient,
+		MaxRetries:  aws.Int(c.MaxRetries),
+		Region:      aws.String(c.Region),
+	}
+
+	if logging.IsDebugOrHigher() {
+		awsConfig.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody | aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
+		awsConfig.Logger = awsbase.DebugLogger{}
+	}
+
+	sess, err := session.NewSession(awsConfig)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating assume role with web identity session: %w", err)
+	}
+
+	sessionName := c.AssumeRoleWithWebIdentitySessionName
+
+	if sessionName == "" {
+		sessionName = strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(c.AssumeRoleWithWebIdentityARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(token),
+	}
+
+	if c.AssumeRoleWithWebIdentityDurationSeconds > 0 {
+		input.DurationSeconds = aws.Int64(int64(c.AssumeRoleWithWebIdentityDurationSeconds))
+	}
+
+	if c.AssumeRoleWithWebIdentityPolicy != "" {
+		input.Policy = aws.String(c.AssumeRoleWithWebIdentityPolicy)
+	}
+
+	for _, policyARN := range c.AssumeRoleWithWebIdentityPolicyARNs {
+		input.PolicyArns = append(input.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q)", c.AssumeRoleWithWebIdentityARN, sessionName)
+
+	output, err := sts.New(sess).AssumeRoleWithWebIdentity(input)
+
+	if err != nil {
+		return nil, fmt.Errorf("error assuming role with web identity (%s): %w", c.AssumeRoleWithWebIdentityARN, err)
+	}
+
+	if output == nil || output.Credentials == nil {
+		return nil, fmt.Errorf("error assuming role with web identity (%s): empty credentials", c.AssumeRoleWithWebIdentityARN)
+	}
+
+	return output.Credentials, nil
+}
+
+// assumeRoleChain assumes each role of AssumeRoleChain in order, followed by
+// the AssumeRole* role, each using the credentials of the previous role, and
+// returns the credentials of the last one. Without roles to assume, the
+// given credentials are returned.
+func (c *Config) assumeRoleChain(creds *credentials.Credentials, awsbaseConfig *awsbase.Config, httpClient *http.Client) (*credentials.Credentials, error) {
+	if c.AssumeRoleARN == "" && len(c.AssumeRoleChain) == 0 {
+		return creds, nil
+	}
+
+	assumeRoles := make([]AssumeRole, 0, len(c.AssumeRoleChain)+1)
+	assumeRoles = append(assumeRoles, c.AssumeRoleChain...)
+	assumeRoles = append(assumeRoles, AssumeRole{
+		ARN:               c.AssumeRoleARN,
+		DurationSeconds:   c.AssumeRoleDurationSeconds,
+		ExternalID:        c.AssumeRoleExternalID,
+		Policy:            c.AssumeRolePolicy,
+		PolicyARNs:        c.AssumeRolePolicyARNs,
+		SessionName:       c.AssumeRoleSessionName,
+		Tags:              c.AssumeRoleTags,
+		TransitiveTagKeys: c.AssumeRoleTransitiveTagKeys,
+	})
+	hops := len(assumeRoles)
+
+	for i, assumeRole := range assumeRoles {
+		if assumeRole.ARN == "" {
+			return nil, fmt.Errorf("error assuming role (assume_role %d of %d): role_arn must be configured", i+1, hops)
+		}
+
+		log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)", assumeRole.ARN, assumeRole.SessionName, assumeRole.ExternalID)
+
+		sess, err := c.credentialsSession(creds, awsbaseConfig, httpClient)
+
+		if err != nil {
+			return nil, fmt.Errorf("error creating assume role session: %w", err)
+		}
+
+		creds = credentials.NewCredentials(expandAssumeRoleProvider(sts.New(sess), assumeRole))
+
+		if _, err := creds.Get(); err != nil {
+			hopConfig := *awsbaseConfig
+			hopConfig.AssumeRoleARN = assumeRole.ARN
+
+			if hops == 1 {
+				return nil, hopConfig.NewCannotAssumeRoleError(err)
+			}
+
+			return nil, fmt.Errorf("error assuming role (%s) (assume_role %d of %d): %w", assumeRole.ARN, i+1, hops, hopConfig.NewCannotAssumeRoleError(err))
+		}
+	}
+
+	return creds, nil
+}
+
+// expandAssumeRoleProvider returns a provider of the credentials of the
+// given role, which are refreshed before they expire.
+func expandAssumeRoleProvider(client *sts.STS, assumeRole AssumeRole) *stscreds.AssumeRoleProvider {
+	provider := &stscreds.AssumeRoleProvider{
+		Client:  client,
+		RoleARN: assumeRole.ARN,
+	}
+
+	if assumeRole.DurationSeconds > 0 {
+		provider.Duration = time.Duration(assumeRole.DurationSeconds) * time.Second
+	}
+
+	if assumeRole.ExternalID != "" {
+		provider.ExternalID = aws.String(assumeRole.ExternalID)
+	}
+
+	if assumeRole.Policy != "" {
+		provider.Policy = aws.String(assumeRole.Policy)
+	}
+
+	for _, policyARN := range assumeRole.PolicyARNs {
+		provider.PolicyArns = append(provider.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	if assumeRole.SessionName != "" {
+		provider.RoleSessionName = assumeRole.SessionName
+	}
+
+	for k, v := range assumeRole.Tags {
+		provider.Tags = append(provider.Tags, &sts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	if len(assumeRole.TransitiveTagKeys) > 0 {
+		provider.TransitiveTagKeys = aws.StringSlice(assumeRole.TransitiveTagKeys)
+	}
+
+	return provider
+}
+
+// credentialsSession returns a session for the STS requests made while
+// configuring the provider credentials.
+func (c *Config) credentialsSession(creds *credentials.Credentials, awsbaseConfig *awsbase.Config, httpClient *http.Client) (*session.Session, error) {
+	awsConfig := &aws.Config{
+		Credentials:      creds,
+		EndpointResolver: awsbaseConfig.EndpointResolver(),
+		HTTPClient:       httpClient,
+		MaxRetries:       aws.Int(c.MaxRetries),
+		Region:           aws.String(c.Region),
+	}
+
+	if logging.IsDebugOrHigher() {
+		awsConfig.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody | aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
+		awsConfig.Logger = awsbase.DebugLogger{}
+	}
+
+	return session.NewSession(awsConfig)
+}
+
+// session returns the provider session with the given credentials, along
+// with the account ID and partition of the credentials where available.
+// It mirrors aws-sdk-go-base, which creates its own HTTP clients.
+func (c *Config) session(creds *credentials.Credentials, awsbaseConfig *awsbase.Config, httpClient *http.Client) (*session.Session, string, string, error) {
+	options := session.Options{
+		Config: aws.Config{
+			Credentials:      creds,
+			EndpointResolver: awsbaseConfig.EndpointResolver(),
+			HTTPClient:       httpClient,
+			MaxRetries:       aws.Int(c.MaxRetries),
+			Region:           aws.String(c.Region),
+		},
+		Profile:           c.Profile,
+		SharedConfigState: session.SharedConfigEnable,
+	}
+
+	if logging.IsDebugOrHigher() {
+		options.Config.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody | aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
+		options.Config.Logger = awsbase.DebugLogger{}
+	}
+
+	sess, err := session.NewSessionWithOptions(options)
+
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+			return nil, "", "", awsbaseConfig.NewNoValidCredentialSourcesError(err)
+		}
+
+		return nil, "", "", fmt.Errorf("error creating AWS session: %w", err)
+	}
+
+	for _, product := range awsbaseConfig.UserAgentProducts {
+		sess.Handlers.Build.PushBack(request.MakeAddToUserAgentHandler(product.Name, product.Version, product.Extra...))
+	}
+
+	if v := os.Getenv(awsbase.AppendUserAgentEnvVar); v != "" {
+		log.Printf("[DEBUG] Using additional User-Agent Info: %s", v)
+		sess.Handlers.Build.PushBack(request.MakeAddToUserAgentFreeFormHandler(v))
+	}
+
+	// Disable retries of permanent networking failures, such as a
+	// non-existent service endpoint, after a lower threshold.
+	sess.Handlers.Retry.PushBack(func(r *request.Request) {
+		if r.RetryCount < awsbase.MaxNetworkRetryCount {
+			return
+		}
+
+		if tfawserr.ErrMessageAndOrigErrContain(r.Error, "RequestError", "send request failed", "no such host") ||
+			tfawserr.ErrMessageAndOrigErrContain(r.Error, "RequestError", "send request failed", "connection refused") {
+			log.Printf("[WARN] Disabling retries after next request due to networking issue")
+			r.Retryable = aws.Bool(false)
+		}
+	})
+
+	if !c.SkipCredsValidation {
+		accountID, partition, err := awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(sts.New(sess))
+
+		if err != nil {
+			return nil, "", "", fmt.Errorf("error validating provider credentials: %w", err)
+		}
+
+		return sess, accountID, partition, nil
+	}
+
+	if c.AssumeRoleARN != "" {
+		if v, err := arn.Parse(c.AssumeRoleARN); err == nil {
+			return sess, v.AccountID, v.Partition, nil
+		}
+	}
+
+	if !c.SkipRequestingAccountId {
+		credentialsProviderName := ""
+
+		if credentialsValue, err := creds.Get(); err == nil {
+			credentialsProviderName = credentialsValue.ProviderName
+		}
+
+		accountID, partition, err := awsbase.GetAccountIDAndPartition(iam.New(sess), sts.New(sess), credentialsProviderName)
+
+		if err != nil {
+			return nil, "", "", fmt.Errorf(
+				"AWS account ID not previously found and failed retrieving via all available methods. "+
+					"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
+					"Errors: %w", err)
+		}
+
+		return sess, accountID, partition, nil
+	}
+
+	var partition string
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		partition = p.ID()
+	}
+
+	return sess, "", partition, nil
+}
+
 // Client configures and returns a fully initialized AWSClient
 func (c *Config) Client() (interface{}, error) {
 	// Get the auth and region. This can fail if keys/regions were not
@@ -381,6 +818,12 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
+	httpClient, err := c.httpClient()
+
+	if err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
 	awsbaseConfig := &awsbase.Config{
 		AccessKey:                   c.AccessKey,
 		AssumeRoleARN:               c.AssumeRoleARN,
@@ -414,11 +857,100 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
-	sess, accountID, partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
+	// Credential validation, account lookup and role assumption use the
+	// FIPS endpoints as well.
+	if c.UseFIPSEndpoint {
+		resolver := endpointresolver.New(nil, c.UseFIPSEndpoint, c.UseDualStackEndpoint)
+
+		if awsbaseConfig.IamEndpoint == "" {
+			endpoint, err := resolver.EndpointFor(iam.EndpointsID, c.Region)
+
+			if err != nil {
+				return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+			}
+
+			awsbaseConfig.IamEndpoint = endpoint.URL
+		}
+
+		if awsbaseConfig.StsEndpoint == "" {
+			endpoint, err := resolver.EndpointFor(sts.EndpointsID, c.Region)
+
+			if err != nil {
+				return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+			}
+
+			awsbaseConfig.StsEndpoint = endpoint.URL
+		}
+	}
+
+	if c.SkipMetadataApiCheck {
+		os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	}
+
+	var creds *credentials.Credentials
+
+	// The web identity credentials become the source credentials for the session,
+	// allowing an additional assume_role configuration to be chained after them.
+	if c.AssumeRoleWithWebIdentityARN != "" {
+		webIdentityCreds, err := c.assumeRoleWithWebIdentity(httpClient)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		creds = credentials.NewStaticCredentials(aws.StringValue(webIdentityCreds.AccessKeyId), aws.StringValue(webIdentityCreds.SecretAccessKey), aws.StringValue(webIdentityCreds.SessionToken))
+	} else {
+		// Roles are assumed below, using the shared HTTP client.
+		sourceConfig := *awsbaseConfig
+		sourceConfig.AssumeRoleARN = ""
+
+		creds, err = awsbase.GetCredentials(&sourceConfig)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+	}
+
+	creds, err = c.assumeRoleChain(creds, awsbaseConfig, httpClient)
+
+	if err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	sess, accountID, partition, err := c.session(creds, awsbaseConfig, httpClient)
+
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	// Per-service endpoints configuration takes precedence, as setting the
+	// endpoint of a client bypasses its endpoint resolver.
+	if c.UseFIPSEndpoint || c.UseDualStackEndpoint {
+		sess = sess.Copy(&aws.Config{
+			EndpointResolver: endpointresolver.New(sess.Config.EndpointResolver, c.UseFIPSEndpoint, c.UseDualStackEndpoint),
+			UseDualStack:     aws.Bool(c.UseDualStackEndpoint),
+		})
+	}
+
+	// Handlers added to the session are copied to each service client.
+	if retryConfig := retryer.NewConfig(c.RetryMode, c.MaxRetries, c.ServiceRetryPolicies); !retryConfig.IsDefault() {
+		retryConfig.AddHandlers(&sess.Handlers)
+	}
+
+	if len(c.ServiceRequestLimits) > 0 {
+		limiter.New(c.ServiceRequestLimits).AddHandlers(&sess.Handlers)
+	}
+
+	if c.APILogFile != "" {
+		logger, err := apilog.Open(c.APILogFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		logger.AddHandlers(&sess.Handlers)
+	}
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -475,6 +1007,7 @@ func (c *Config) Client() (interface{}, error) {
 		datapipelineconn:                    datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datapipeline"])})),
 		datasyncconn:                        datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datasync"])})),
 		daxconn:                             dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dax"])})),
+		DefaultTagsConfig:                   c.DefaultTagsConfig,
 		devicefarmconn:                      devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["devicefarm"])})),
 		dlmconn:                             dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dlm"])})),
 		dmsconn:                             databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dms"])})),
@@ -549,9 +1082,9 @@ func (c *Config) Client() (interface{}, error) {
 		rdsconn:                             rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["rds"])})),
 		redshiftconn:                        redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["redshift"])})),
 		region:                              c.Region,
+		RequiredTagsConfig:                  c.RequiredTagsConfig,
 		resourcegroupsconn:                  resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroups"])})),
 		resourcegroupstaggingapiconn:        resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroupstaggingapi"])})),
-		route53domainsconn:                  route53domains.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53domains"])})),
 		route53resolverconn:                 route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53resolver"])})),
 		s3controlconn:                       s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3control"])})),
 		s3outpostsconn:                      s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3outposts"])})),
@@ -592,6 +1125,9 @@ func (c *Config) Client() (interface{}, error) {
 	route53Config := &aws.Config{
 		Endpoint: aws.String(c.Endpoints["route53"]),
 	}
+	route53DomainsConfig := &aws.Config{
+		Endpoint: aws.String(c.Endpoints["route53domains"]),
+	}
 	shieldConfig := &aws.Config{
 		Endpoint: aws.String(c.Endpoints["shield"]),
 	}
@@ -612,6 +1148,7 @@ func (c *Config) Client() (interface{}, error) {
 	case endpoints.AwsPartitionID:
 		globalAcceleratorConfig.Region = aws.String(endpoints.UsWest2RegionID)
 		route53Config.Region = aws.String(endpoints.UsEast1RegionID)
+		route53DomainsConfig.Region = aws.String(endpoints.UsEast1RegionID)
 		shieldConfig.Region = aws.String(endpoints.UsEast1RegionID)
 	case endpoints.AwsCnPartitionID:
 		// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
@@ -626,6 +1163,7 @@ func (c *Config) Client() (interface{}, error) {
 
 	client.globalacceleratorconn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
 	client.r53conn = route53.New(sess.Copy(route53Config))
+	client.route53domainsconn = route53domains.New(sess.Copy(route53DomainsConfig))
 	client.shieldconn = shield.New(sess.Copy(shieldConfig))
 
 	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
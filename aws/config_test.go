@@ -1,11 +1,21 @@
 package aws
 
 import (
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/go-cleanhttp"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) {
@@ -114,6 +124,367 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+func TestConfigAssumeRoleWithWebIdentity(t *testing.T) {
+	stsEndpoints := []*awsbase.MockEndpoint{
+		{
+			Request: &awsbase.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   "Action=AssumeRoleWithWebIdentity&DurationSeconds=3600&RoleArn=arn%3Aaws%3Aiam%3A%3A555555555555%3Arole%2FWebIdentity&RoleSessionName=WebIdentitySessionName&Version=2011-06-15&WebIdentityToken=WebIdentityToken",
+			},
+			Response: &awsbase.MockResponse{
+				StatusCode:  200,
+				Body:        test_sts_assumeRoleWithWebIdentity_response,
+				ContentType: "text/xml",
+			},
+		},
+	}
+	ts := awsbase.MockAwsApiServer("STS", stsEndpoints)
+	defer ts.Close()
+
+	tokenFile, err := ioutil.TempFile("", "web-identity-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tokenFile.Name())
+
+	if _, err := tokenFile.WriteString("WebIdentityToken\n"); err != nil {
+		t.Fatal(err)
+	}
+	tokenFile.Close()
+
+	testCases := []struct {
+		Name        string
+		Config      *Config
+		ExpectError bool
+	}{
+		{
+			Name: "token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityToken: "WebIdentityToken",
+			},
+		},
+		{
+			Name: "token file",
+			Config: &Config{
+				AssumeRoleWithWebIdentityTokenFile: tokenFile.Name(),
+			},
+		},
+		{
+			Name:        "no token",
+			Config:      &Config{},
+			ExpectError: true,
+		},
+		{
+			Name: "missing token file",
+			Config: &Config{
+				AssumeRoleWithWebIdentityTokenFile: tokenFile.Name() + "-missing",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "invalid token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityToken: "InvalidWebIdentityToken",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := testCase.Config
+			config.AssumeRoleWithWebIdentityARN = "arn:aws:iam::555555555555:role/WebIdentity"
+			config.AssumeRoleWithWebIdentityDurationSeconds = 3600
+			config.AssumeRoleWithWebIdentitySessionName = "WebIdentitySessionName"
+			config.Endpoints = map[string]string{"sts": ts.URL}
+			config.Region = "us-east-1"
+
+			creds, err := config.assumeRoleWithWebIdentity(cleanhttp.DefaultClient())
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := aws.StringValue(creds.AccessKeyId), "WebIdentityAccessKey"; got != expected {
+				t.Errorf("got access key %s, expected %s", got, expected)
+			}
+
+			if got, expected := aws.StringValue(creds.SecretAccessKey), "WebIdentitySecretKey"; got != expected {
+				t.Errorf("got secret key %s, expected %s", got, expected)
+			}
+
+			if got, expected := aws.StringValue(creds.SessionToken), "WebIdentitySessionToken"; got != expected {
+				t.Errorf("got session token %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigAssumeRoleChain(t *testing.T) {
+	stsEndpoints := []*awsbase.MockEndpoint{
+		awsbase.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+			"ExternalId":      "HubExternalId",
+			"RoleArn":         "arn:aws:iam::555555555555:role/Hub",
+			"RoleSessionName": "HubSessionName",
+		}),
+		awsbase.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+			"RoleArn":         "arn:aws:iam::666666666666:role/Workload",
+			"RoleSessionName": "WorkloadSessionName",
+		}),
+	}
+	ts := awsbase.MockAwsApiServer("STS", stsEndpoints)
+	defer ts.Close()
+
+	testCases := []struct {
+		Name          string
+		Config        *Config
+		ExpectedError string
+	}{
+		{
+			Name: "chain",
+			Config: &Config{
+				AssumeRoleARN:         "arn:aws:iam::666666666666:role/Workload",
+				AssumeRoleSessionName: "WorkloadSessionName",
+				AssumeRoleChain: []AssumeRole{
+					{
+						ARN:         "arn:aws:iam::555555555555:role/Hub",
+						ExternalID:  "HubExternalId",
+						SessionName: "HubSessionName",
+					},
+				},
+			},
+		},
+		{
+			Name: "chain role_arn missing",
+			Config: &Config{
+				AssumeRoleARN:         "arn:aws:iam::666666666666:role/Workload",
+				AssumeRoleSessionName: "WorkloadSessionName",
+				AssumeRoleChain: []AssumeRole{
+					{
+						SessionName: "HubSessionName",
+					},
+				},
+			},
+			ExpectedError: "assume_role 1 of 2",
+		},
+		{
+			Name: "final role_arn missing",
+			Config: &Config{
+				AssumeRoleChain: []AssumeRole{
+					{
+						ARN:         "arn:aws:iam::555555555555:role/Hub",
+						ExternalID:  "HubExternalId",
+						SessionName: "HubSessionName",
+					},
+				},
+			},
+			ExpectedError: "assume_role 2 of 2",
+		},
+		{
+			Name: "chain assume role failure",
+			Config: &Config{
+				AssumeRoleARN:         "arn:aws:iam::666666666666:role/Workload",
+				AssumeRoleSessionName: "WorkloadSessionName",
+				AssumeRoleChain: []AssumeRole{
+					{
+						ARN:         "arn:aws:iam::555555555555:role/Hub",
+						ExternalID:  "InvalidExternalId",
+						SessionName: "HubSessionName",
+					},
+				},
+			},
+			ExpectedError: "arn:aws:iam::555555555555:role/Hub) (assume_role 1 of 2)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			awsbaseConfig := &awsbase.Config{
+				AccessKey:   "accessKey",
+				Region:      "us-east-1",
+				SecretKey:   "secretKey",
+				StsEndpoint: ts.URL,
+			}
+
+			testCase.Config.Region = "us-east-1"
+
+			creds, err := testCase.Config.assumeRoleChain(credentials.NewStaticCredentials("accessKey", "secretKey", ""), awsbaseConfig, cleanhttp.DefaultClient())
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %s", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			value, err := creds.Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := value.AccessKeyID, awsbase.MockStsAssumeRoleAccessKey; got != expected {
+				t.Errorf("got access key %s, expected %s", got, expected)
+			}
+
+			if got, expected := value.SecretAccessKey, awsbase.MockStsAssumeRoleSecretKey; got != expected {
+				t.Errorf("got secret key %s, expected %s", got, expected)
+			}
+
+			if got, expected := value.SessionToken, awsbase.MockStsAssumeRoleSessionToken; got != expected {
+				t.Errorf("got session token %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigConfigureHTTPClient(t *testing.T) {
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "tls")
+	}))
+	defer tlsServer.Close()
+
+	proxyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "proxied %s", r.URL.Host)
+	}))
+	defer proxyServer.Close()
+
+	caBundle, err := ioutil.TempFile("", "ca-bundle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(caBundle.Name())
+
+	if err := pem.Encode(caBundle, &pem.Block{Type: "CERTIFICATE", Bytes: tlsServer.Certificate().Raw}); err != nil {
+		t.Fatal(err)
+	}
+	caBundle.Close()
+
+	testCases := []struct {
+		Name         string
+		Config       *Config
+		URL          string
+		ExpectedBody string
+		ExpectError  bool
+	}{
+		{
+			Name:        "no custom CA bundle",
+			Config:      &Config{},
+			URL:         tlsServer.URL,
+			ExpectError: true,
+		},
+		{
+			Name: "custom CA bundle",
+			Config: &Config{
+				CustomCABundle: caBundle.Name(),
+			},
+			URL:          tlsServer.URL,
+			ExpectedBody: "tls",
+		},
+		{
+			Name: "http proxy",
+			Config: &Config{
+				HTTPProxy: proxyServer.URL,
+			},
+			URL:          "http://ec2.us-east-1.amazonaws.com/",
+			ExpectedBody: "proxied ec2.us-east-1.amazonaws.com",
+		},
+		{
+			Name: "http proxy no proxy",
+			Config: &Config{
+				HTTPProxy: proxyServer.URL,
+				NoProxy:   "sts.us-east-1.amazonaws.com",
+			},
+			URL:          "http://ec2.us-east-1.amazonaws.com/",
+			ExpectedBody: "proxied ec2.us-east-1.amazonaws.com",
+		},
+		{
+			Name: "http proxy no proxy match",
+			Config: &Config{
+				HTTPProxy: proxyServer.URL,
+				NoProxy:   ".invalid",
+			},
+			URL:         "http://ec2.test.invalid/",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := cleanhttp.DefaultClient()
+
+			if err := testCase.Config.configureHTTPClient(client); err != nil {
+				t.Fatalf("unexpected error configuring HTTP client: %s", err)
+			}
+
+			resp, err := client.Get(testCase.URL)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			defer resp.Body.Close()
+
+			b, err := ioutil.ReadAll(resp.Body)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, expected := string(b), testCase.ExpectedBody; got != expected {
+				t.Errorf("got body %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
+var test_sts_assumeRoleWithWebIdentity_response = `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <SubjectFromWebIdentityToken>amzn1.account.AF6RHO7KZU5XRVQJGXK6HB56KR2A</SubjectFromWebIdentityToken>
+    <Audience>client.5498841531868486423.1548@apps.example.com</Audience>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::555555555555:assumed-role/WebIdentity/WebIdentitySessionName</Arn>
+      <AssumedRoleId>AROACLKWSDQRAOEXAMPLE:WebIdentitySessionName</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <SessionToken>WebIdentitySessionToken</SessionToken>
+      <SecretAccessKey>WebIdentitySecretKey</SecretAccessKey>
+      <Expiration>2099-12-31T23:59:59Z</Expiration>
+      <AccessKeyId>WebIdentityAccessKey</AccessKeyId>
+    </Credentials>
+    <Provider>www.amazon.com</Provider>
+  </AssumeRoleWithWebIdentityResult>
+  <ResponseMetadata>
+    <RequestId>ad4156e9-bce1-11e2-82e6-6b6efEXAMPLE</RequestId>
+  </ResponseMetadata>
+</AssumeRoleWithWebIdentityResponse>`
+
 var test_ec2_describeAccountAttributes_response = `<DescribeAccountAttributesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
   <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
   <accountAttributeSet>
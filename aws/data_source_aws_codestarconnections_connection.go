@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/codestarconnections/finder"
+)
+
+func dataSourceAwsCodeStarConnectionsConnection() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCodeStarConnectionsConnectionRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"host_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provider_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsCodeStarConnectionsConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codestarconnectionsconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	arn := d.Get("arn").(string)
+	connection, err := finder.ConnectionByArn(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error getting CodeStar Connections Connection (%s): %w", arn, err)
+	}
+
+	if connection == nil {
+		return fmt.Errorf("error getting CodeStar Connections Connection (%s): empty response", arn)
+	}
+
+	d.SetId(aws.StringValue(connection.ConnectionArn))
+	d.Set("arn", connection.ConnectionArn)
+	d.Set("connection_status", connection.ConnectionStatus)
+	d.Set("host_arn", connection.HostArn)
+	d.Set("name", connection.ConnectionName)
+	d.Set("provider_type", connection.ProviderType)
+
+	tags, err := keyvaluetags.CodestarconnectionsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for CodeStar Connections Connection (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
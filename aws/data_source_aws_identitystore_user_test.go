@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSIdentityStoreUserDataSource_UserName(t *testing.T) {
+	dataSourceName := "data.aws_identitystore_user.test"
+	name := os.Getenv("AWS_IDENTITY_STORE_USER_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreUserName(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIdentityStoreUserDataSourceConfigUserName(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "user_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "user_name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIdentityStoreUserDataSource_NonExistent(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSSSOAdminInstances(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIdentityStoreUserDataSourceConfigUserName("does-not-exist"),
+				ExpectError: regexp.MustCompile(`no Identity Store User found matching criteria`),
+			},
+		},
+	})
+}
+
+func testAccAWSIdentityStoreUserDataSourceConfigUserName(name string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  filter {
+    attribute_path  = "UserName"
+    attribute_value = %q
+  }
+}
+`, name)
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/imagebuilder/finder"
+)
+
+func dataSourceAwsImageBuilderComponent() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsImageBuilderComponentRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"build_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"change_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"supported_os_versions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsImageBuilderComponentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	arn := d.Get("arn").(string)
+
+	component, err := finder.ComponentByArn(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error getting Image Builder Component (%s): %w", arn, err)
+	}
+
+	if component == nil {
+		return fmt.Errorf("error getting Image Builder Component (%s): empty response", arn)
+	}
+
+	d.SetId(aws.StringValue(component.Arn))
+
+	d.Set("build_version_arn", component.Arn)
+	d.Set("change_description", component.ChangeDescription)
+	d.Set("data", component.Data)
+	d.Set("date_created", component.DateCreated)
+	d.Set("description", component.Description)
+	d.Set("encrypted", component.Encrypted)
+	d.Set("kms_key_id", component.KmsKeyId)
+	d.Set("name", component.Name)
+	d.Set("owner", component.Owner)
+	d.Set("platform", component.Platform)
+	d.Set("supported_os_versions", aws.StringValueSlice(component.SupportedOsVersions))
+
+	if err := d.Set("tags", keyvaluetags.ImagebuilderKeyValueTags(component.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	d.Set("type", component.Type)
+	d.Set("version", component.Version)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAwsImageBuilderComponentDataSource_Arn(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_imagebuilder_component.test"
+	resourceName := "aws_imagebuilder_component.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(imagebuilder.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsImageBuilderComponentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderComponentDataSourceConfigArn(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "build_version_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "change_description", resourceName, "change_description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "data", resourceName, "data"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "date_created", resourceName, "date_created"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "encrypted", resourceName, "encrypted"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "kms_key_id", resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "owner", resourceName, "owner"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "platform", resourceName, "platform"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "supported_os_versions.#", resourceName, "supported_os_versions.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "type", resourceName, "type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", resourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsImageBuilderComponentDataSource_VersionWildcard(t *testing.T) {
+	dataSourceName := "data.aws_imagebuilder_component.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(imagebuilder.EndpointsID, t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsImageBuilderComponentDataSourceConfigVersionWildcard(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceAttrRegionalARNAccountID(dataSourceName, "arn", "imagebuilder", "aws", "component/update-linux/x.x.x"),
+					resource.TestMatchResourceAttr(dataSourceName, "build_version_arn", regexp.MustCompile(`component/update-linux/\d+\.\d+\.\d+/\d+$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "update-linux"),
+					resource.TestCheckResourceAttr(dataSourceName, "owner", "Amazon"),
+					resource.TestCheckResourceAttr(dataSourceName, "platform", imagebuilder.PlatformLinux),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsImageBuilderComponentDataSourceConfigArn(rName string) string {
+	return composeConfig(
+		testAccAwsImageBuilderComponentConfigRequired(rName),
+		`
+data "aws_imagebuilder_component" "test" {
+  arn = aws_imagebuilder_component.test.arn
+}
+`)
+}
+
+func testAccAwsImageBuilderComponentDataSourceConfigVersionWildcard() string {
+	return `
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_imagebuilder_component" "test" {
+  arn = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.name}:aws:component/update-linux/x.x.x"
+}
+`
+}
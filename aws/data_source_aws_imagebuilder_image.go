@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/imagebuilder/finder"
+)
+
+func dataSourceAwsImageBuilderImage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsImageBuilderImageRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"build_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"distribution_configuration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enhanced_image_metadata_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"image_recipe_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_tests_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"image_tests_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"timeout_minutes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"infrastructure_configuration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"os_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amis": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"image": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsImageBuilderImageRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).imagebuilderconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	arn := d.Get("arn").(string)
+
+	image, err := finder.ImageByArn(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error getting Image Builder Image (%s): %w", arn, err)
+	}
+
+	if image == nil {
+		return fmt.Errorf("error getting Image Builder Image (%s): empty response", arn)
+	}
+
+	d.SetId(aws.StringValue(image.Arn))
+
+	d.Set("build_version_arn", image.Arn)
+	d.Set("date_created", image.DateCreated)
+
+	if image.DistributionConfiguration != nil {
+		d.Set("distribution_configuration_arn", image.DistributionConfiguration.Arn)
+	}
+
+	d.Set("enhanced_image_metadata_enabled", image.EnhancedImageMetadataEnabled)
+
+	if image.ImageRecipe != nil {
+		d.Set("image_recipe_arn", image.ImageRecipe.Arn)
+	}
+
+	if image.ImageTestsConfiguration != nil {
+		if err := d.Set("image_tests_configuration", []interface{}{flattenImageBuilderImageTestsConfiguration(image.ImageTestsConfiguration)}); err != nil {
+			return fmt.Errorf("error setting image_tests_configuration: %w", err)
+		}
+	} else {
+		d.Set("image_tests_configuration", nil)
+	}
+
+	if image.InfrastructureConfiguration != nil {
+		d.Set("infrastructure_configuration_arn", image.InfrastructureConfiguration.Arn)
+	}
+
+	d.Set("name", image.Name)
+	d.Set("os_version", image.OsVersion)
+
+	if image.OutputResources != nil {
+		if err := d.Set("output_resources", []interface{}{flattenImageBuilderOutputResources(image.OutputResources)}); err != nil {
+			return fmt.Errorf("error setting output_resources: %w", err)
+		}
+	} else {
+		d.Set("output_resources", nil)
+	}
+
+	d.Set("platform", image.Platform)
+
+	if err := d.Set("tags", keyvaluetags.ImagebuilderKeyValueTags(image.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	d.Set("version", image.Version)
+
+	return nil
+}
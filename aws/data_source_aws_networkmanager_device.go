@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+)
+
+func dataSourceAwsNetworkManagerDevice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsNetworkManagerDeviceRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"location": dataSourceNetworkManagerLocationSchema(),
+			"model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"serial_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"site_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vendor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsNetworkManagerDeviceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	deviceID := d.Get("device_id").(string)
+
+	device, err := finder.DeviceByID(conn, globalNetworkID, deviceID)
+
+	if err != nil {
+		return fmt.Errorf("error getting Network Manager Device (%s): %w", deviceID, err)
+	}
+
+	if device == nil {
+		return fmt.Errorf("error getting Network Manager Device (%s): empty response", deviceID)
+	}
+
+	d.SetId(aws.StringValue(device.DeviceId))
+
+	d.Set("arn", device.DeviceArn)
+	d.Set("description", device.Description)
+	d.Set("device_id", device.DeviceId)
+	d.Set("global_network_id", device.GlobalNetworkId)
+
+	if err := d.Set("location", flattenNetworkManagerLocation(device.Location)); err != nil {
+		return fmt.Errorf("error setting location: %w", err)
+	}
+
+	d.Set("model", device.Model)
+	d.Set("serial_number", device.SerialNumber)
+	d.Set("site_id", device.SiteId)
+	d.Set("type", device.Type)
+	d.Set("vendor", device.Vendor)
+
+	if err := d.Set("tags", keyvaluetags.NetworkmanagerKeyValueTags(device.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSNetworkManagerDeviceDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_networkmanager_device.test"
+	resourceName := "aws_networkmanager_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerDeviceDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "device_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "global_network_id", resourceName, "global_network_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "location.#", resourceName, "location.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "location.0.address", resourceName, "location.0.address"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "model", resourceName, "model"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "serial_number", resourceName, "serial_number"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "site_id", resourceName, "site_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "type", resourceName, "type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vendor", resourceName, "vendor"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSNetworkManagerDeviceDataSourceConfig() string {
+	return composeConfig(
+		testAccAWSNetworkManagerDeviceConfigAllAttributes("description1", "model1", "sn1", "type1", "vendor1"),
+		`
+data "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  device_id         = aws_networkmanager_device.test.id
+}
+`)
+}
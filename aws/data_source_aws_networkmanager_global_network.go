@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+)
+
+func dataSourceAwsNetworkManagerGlobalNetwork() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsNetworkManagerGlobalNetworkRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsNetworkManagerGlobalNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	globalNetwork, err := finder.GlobalNetworkByID(conn, globalNetworkID)
+
+	if err != nil {
+		return fmt.Errorf("error getting Network Manager Global Network (%s): %w", globalNetworkID, err)
+	}
+
+	if globalNetwork == nil {
+		return fmt.Errorf("error getting Network Manager Global Network (%s): empty response", globalNetworkID)
+	}
+
+	d.SetId(aws.StringValue(globalNetwork.GlobalNetworkId))
+
+	d.Set("arn", globalNetwork.GlobalNetworkArn)
+	d.Set("description", globalNetwork.Description)
+	d.Set("global_network_id", globalNetwork.GlobalNetworkId)
+
+	if err := d.Set("tags", keyvaluetags.NetworkmanagerKeyValueTags(globalNetwork.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
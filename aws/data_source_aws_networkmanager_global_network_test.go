@@ -0,0 +1,39 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSNetworkManagerGlobalNetworkDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_networkmanager_global_network.test"
+	resourceName := "aws_networkmanager_global_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerGlobalNetworkDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "global_network_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSNetworkManagerGlobalNetworkDataSourceConfig() string {
+	return composeConfig(
+		testAccAWSNetworkManagerGlobalNetworkConfigDescription("test"),
+		`
+data "aws_networkmanager_global_network" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+`)
+}
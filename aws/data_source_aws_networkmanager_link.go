@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+)
+
+func dataSourceAwsNetworkManagerLink() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsNetworkManagerLinkRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bandwidth": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"download_speed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"upload_speed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"link_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provider_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"site_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsNetworkManagerLinkRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	linkID := d.Get("link_id").(string)
+
+	link, err := finder.LinkByID(conn, globalNetworkID, linkID)
+
+	if err != nil {
+		return fmt.Errorf("error getting Network Manager Link (%s): %w", linkID, err)
+	}
+
+	if link == nil {
+		return fmt.Errorf("error getting Network Manager Link (%s): empty response", linkID)
+	}
+
+	d.SetId(aws.StringValue(link.LinkId))
+
+	d.Set("arn", link.LinkArn)
+
+	if err := d.Set("bandwidth", flattenNetworkManagerBandwidth(link.Bandwidth)); err != nil {
+		return fmt.Errorf("error setting bandwidth: %w", err)
+	}
+
+	d.Set("description", link.Description)
+	d.Set("global_network_id", link.GlobalNetworkId)
+	d.Set("link_id", link.LinkId)
+	d.Set("provider_name", link.Provider)
+	d.Set("site_id", link.SiteId)
+	d.Set("type", link.Type)
+
+	if err := d.Set("tags", keyvaluetags.NetworkmanagerKeyValueTags(link.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSNetworkManagerLinkDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_networkmanager_link.test"
+	resourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerLinkDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bandwidth.#", resourceName, "bandwidth.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bandwidth.0.download_speed", resourceName, "bandwidth.0.download_speed"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bandwidth.0.upload_speed", resourceName, "bandwidth.0.upload_speed"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "global_network_id", resourceName, "global_network_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "link_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "provider_name", resourceName, "provider_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "site_id", resourceName, "site_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "type", resourceName, "type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSNetworkManagerLinkDataSourceConfig() string {
+	return composeConfig(
+		testAccAWSNetworkManagerLinkConfigAllAttributes("description1", "provider1", "type1", 100, 20),
+		`
+data "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  link_id           = aws_networkmanager_link.test.id
+}
+`)
+}
@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+)
+
+func dataSourceAwsNetworkManagerSite() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsNetworkManagerSiteRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"location": dataSourceNetworkManagerLocationSchema(),
+			"site_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsNetworkManagerSiteRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	siteID := d.Get("site_id").(string)
+
+	site, err := finder.SiteByID(conn, globalNetworkID, siteID)
+
+	if err != nil {
+		return fmt.Errorf("error getting Network Manager Site (%s): %w", siteID, err)
+	}
+
+	if site == nil {
+		return fmt.Errorf("error getting Network Manager Site (%s): empty response", siteID)
+	}
+
+	d.SetId(aws.StringValue(site.SiteId))
+
+	d.Set("arn", site.SiteArn)
+	d.Set("description", site.Description)
+	d.Set("global_network_id", site.GlobalNetworkId)
+
+	if err := d.Set("location", flattenNetworkManagerLocation(site.Location)); err != nil {
+		return fmt.Errorf("error setting location: %w", err)
+	}
+
+	d.Set("site_id", site.SiteId)
+
+	if err := d.Set("tags", keyvaluetags.NetworkmanagerKeyValueTags(site.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func dataSourceNetworkManagerLocationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"latitude": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"longitude": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
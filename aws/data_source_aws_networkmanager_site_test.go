@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSNetworkManagerSiteDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_networkmanager_site.test"
+	resourceName := "aws_networkmanager_site.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerSiteDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "global_network_id", resourceName, "global_network_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "location.#", resourceName, "location.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "location.0.latitude", resourceName, "location.0.latitude"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "location.0.longitude", resourceName, "location.0.longitude"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "site_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSNetworkManagerSiteDataSourceConfig() string {
+	return composeConfig(
+		testAccAWSNetworkManagerSiteConfigDescriptionAndLocation("test", "18.0029784", "-76.7897987"),
+		`
+data "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+}
+`)
+}
@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsResourceGroupsTaggingAPIResources_TagFilter(t *testing.T) {
+	dataSourceName := "data.aws_resourcegroupstaggingapi_resources.test"
+	resourceName := "aws_vpc.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(resourcegroupstaggingapi.EndpointsID, t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsResourceGroupsTaggingAPIResourcesConfigTagFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tag_mapping_list.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_tag_mapping_list.0.resource_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tag_mapping_list.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tag_mapping_list.0.tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsResourceGroupsTaggingAPIResources_ResourceTypeFilters(t *testing.T) {
+	dataSourceName := "data.aws_resourcegroupstaggingapi_resources.test"
+	resourceName := "aws_vpc.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(resourcegroupstaggingapi.EndpointsID, t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsResourceGroupsTaggingAPIResourcesConfigResourceTypeFilters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tag_mapping_list.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_tag_mapping_list.0.resource_arn", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsResourceGroupsTaggingAPIResources_ResourceTypeFiltersNoMatch(t *testing.T) {
+	dataSourceName := "data.aws_resourcegroupstaggingapi_resources.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(resourcegroupstaggingapi.EndpointsID, t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsResourceGroupsTaggingAPIResourcesConfigResourceTypeFiltersNoMatch(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tag_mapping_list.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsResourceGroupsTaggingAPIResourcesConfigVpc(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccDataSourceAwsResourceGroupsTaggingAPIResourcesConfigTagFilter(rName string) string {
+	return composeConfig(
+		testAccDataSourceAwsResourceGroupsTaggingAPIResourcesConfigVpc(rName),
+		`
+data "aws_resourcegroupstaggingapi_resources" "test" {
+  tag_filter {
+    key    = "Name"
+    values = [aws_vpc.test.tags["Name"]]
+  }
+}
+`)
+}
+
+func testAccDataSourceAwsResourceGroupsTaggingAPIResourcesConfigResourceTypeFilters(rName string) string {
+	return composeConfig(
+		testAccDataSourceAwsResourceGroupsTaggingAPIResourcesConfigVpc(rName),
+		`
+data "aws_resourcegroupstaggingapi_resources" "test" {
+  resource_type_filters = ["ec2:vpc"]
+
+  tag_filter {
+    key    = "Name"
+    values = [aws_vpc.test.tags["Name"]]
+  }
+}
+`)
+}
+
+func testAccDataSourceAwsResourceGroupsTaggingAPIResourcesConfigResourceTypeFiltersNoMatch(rName string) string {
+	return composeConfig(
+		testAccDataSourceAwsResourceGroupsTaggingAPIResourcesConfigVpc(rName),
+		`
+data "aws_resourcegroupstaggingapi_resources" "test" {
+  resource_type_filters = ["ec2:instance"]
+
+  tag_filter {
+    key    = "Name"
+    values = [aws_vpc.test.tags["Name"]]
+  }
+}
+`)
+}
@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsServerlessApplicationRepositoryApplication_basic(t *testing.T) {
+	datasourceName := "data.aws_serverlessapplicationrepository_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(serverlessapplicationrepository.EndpointsID, t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsServerlessApplicationRepositoryApplicationConfig(testAccAwsServerlessApplicationRepositoryCloudFormationStackApplicationID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "application_id", testAccAwsServerlessApplicationRepositoryCloudFormationStackApplicationID),
+					resource.TestCheckResourceAttr(datasourceName, "name", "SecretsManagerRDSPostgreSQLRotationSingleUser"),
+					resource.TestCheckResourceAttrSet(datasourceName, "semantic_version"),
+					resource.TestMatchResourceAttr(datasourceName, "source_code_url", regexp.MustCompile(`https://.+`)),
+					resource.TestMatchResourceAttr(datasourceName, "template_url", regexp.MustCompile(`https://.+`)),
+					resource.TestCheckResourceAttr(datasourceName, "required_capabilities.#", "2"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "required_capabilities.*", "CAPABILITY_IAM"),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "required_capabilities.*", "CAPABILITY_RESOURCE_POLICY"),
+				),
+			},
+			{
+				Config:      testAccDataSourceAwsServerlessApplicationRepositoryApplicationConfigNonExistent(),
+				ExpectError: regexp.MustCompile(`error getting Serverless Application Repository application`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsServerlessApplicationRepositoryApplication_Versioned(t *testing.T) {
+	datasourceName := "data.aws_serverlessapplicationrepository_application.test"
+
+	const (
+		version1 = "1.0.13"
+		version2 = "1.1.36"
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(serverlessapplicationrepository.EndpointsID, t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsServerlessApplicationRepositoryApplicationConfigVersioned(testAccAwsServerlessApplicationRepositoryCloudFormationStackApplicationID, version1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "semantic_version", version1),
+					resource.TestCheckResourceAttr(datasourceName, "required_capabilities.#", "0"),
+				),
+			},
+			{
+				Config: testAccDataSourceAwsServerlessApplicationRepositoryApplicationConfigVersioned(testAccAwsServerlessApplicationRepositoryCloudFormationStackApplicationID, version2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "semantic_version", version2),
+					resource.TestCheckResourceAttr(datasourceName, "required_capabilities.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsServerlessApplicationRepositoryApplicationConfig(appARN string) string {
+	return fmt.Sprintf(`
+data "aws_serverlessapplicationrepository_application" "test" {
+  application_id = %[1]q
+}
+`, appARN)
+}
+
+func testAccDataSourceAwsServerlessApplicationRepositoryApplicationConfigVersioned(appARN, version string) string {
+	return fmt.Sprintf(`
+data "aws_serverlessapplicationrepository_application" "test" {
+  application_id   = %[1]q
+  semantic_version = %[2]q
+}
+`, appARN, version)
+}
+
+func testAccDataSourceAwsServerlessApplicationRepositoryApplicationConfigNonExistent() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_serverlessapplicationrepository_application" "test" {
+  application_id = "arn:${data.aws_partition.current.partition}:serverlessrepo:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:applications/ThisApplicationDoesNotExist"
+}
+`
+}
@@ -0,0 +1,157 @@
+package apilog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// Entry is a single API call log line.
+type Entry struct {
+	Time            time.Time   `json:"time"`
+	Service         string      `json:"service"`
+	Operation       string      `json:"operation"`
+	Region          string      `json:"region"`
+	RequestID       string      `json:"request_id,omitempty"`
+	LatencyMS       int64       `json:"latency_ms"`
+	RetryCount      int         `json:"retry_count"`
+	StatusCode      int         `json:"status_code,omitempty"`
+	ErrorCode       string      `json:"error_code,omitempty"`
+	ResourceAddress string      `json:"resource_address,omitempty"`
+	Parameters      interface{} `json:"parameters,omitempty"`
+}
+
+// Logger writes one JSON line per API call.
+type Logger struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// New returns a Logger writing to w.
+func New(w io.Writer) *Logger {
+	return &Logger{
+		w: w,
+	}
+}
+
+var (
+	fileLoggersMu sync.Mutex
+	fileLoggers   = make(map[string]*Logger)
+)
+
+// Open returns a Logger appending to the named file. Loggers are shared by
+// filename, so that provider configurations logging to the same file do not
+// interleave lines.
+func Open(filename string) (*Logger, error) {
+	filename, err := homedir.Expand(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding API log file (%s): %w", filename, err)
+	}
+
+	fileLoggersMu.Lock()
+	defer fileLoggersMu.Unlock()
+
+	if logger, ok := fileLoggers[filename]; ok {
+		return logger, nil
+	}
+
+	f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening API log file (%s): %w", filename, err)
+	}
+
+	logger := New(f)
+	fileLoggers[filename] = logger
+
+	return logger, nil
+}
+
+// Log writes the entry.
+func (l *Logger) Log(entry *Entry) error {
+	b, err := json.Marshal(entry)
+
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, err = l.w.Write(append(b, '\n'))
+
+	return err
+}
+
+// AddHandlers adds the handler logging each completed API call, including
+// its retries, to the handlers of a session or client.
+func (l *Logger) AddHandlers(handlers *request.Handlers) {
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.APILogHandler",
+		Fn: func(r *request.Request) {
+			// Logging must never fail the API call.
+			_ = l.Log(NewEntry(r))
+		},
+	})
+}
+
+// NewEntry returns the log entry of a completed request.
+func NewEntry(r *request.Request) *Entry {
+	entry := &Entry{
+		Time:            r.Time.UTC(),
+		Service:         r.ClientInfo.ServiceName,
+		Region:          aws.StringValue(r.Config.Region),
+		RequestID:       r.RequestID,
+		LatencyMS:       time.Since(r.Time).Milliseconds(),
+		RetryCount:      r.RetryCount,
+		ResourceAddress: ResourceAddress(r.Context()),
+		Parameters:      Redact(r.Params),
+	}
+
+	if r.Operation != nil {
+		entry.Operation = r.Operation.Name
+	}
+
+	if r.HTTPResponse != nil {
+		entry.StatusCode = r.HTTPResponse.StatusCode
+	}
+
+	if r.Error != nil {
+		if err, ok := r.Error.(awserr.Error); ok {
+			entry.ErrorCode = err.Code()
+		} else {
+			entry.ErrorCode = "Unknown"
+		}
+	}
+
+	return entry
+}
+
+type resourceAddressContextKey struct{}
+
+// WithResourceAddress returns a context carrying the address of the Terraform
+// resource an API call is made for.
+func WithResourceAddress(ctx context.Context, address string) context.Context {
+	return context.WithValue(ctx, resourceAddressContextKey{}, address)
+}
+
+// ResourceAddress returns the Terraform resource address carried by the context, if any.
+func ResourceAddress(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	v, _ := ctx.Value(resourceAddressContextKey{}).(string)
+
+	return v
+}
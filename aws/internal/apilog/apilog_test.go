@@ -0,0 +1,128 @@
+package apilog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+func TestLoggerAddHandlers(t *testing.T) {
+	var buf bytes.Buffer
+
+	handlers := request.Handlers{}
+	New(&buf).AddHandlers(&handlers)
+
+	handlers.Send.PushBack(func(r *request.Request) {
+		r.HTTPResponse = &http.Response{StatusCode: http.StatusConflict}
+		r.RequestID = "1234"
+		r.Error = awserr.New(iam.ErrCodeEntityAlreadyExistsException, "user already exists", nil)
+	})
+
+	input := &iam.CreateLoginProfileInput{
+		Password: aws.String("hunter2"),
+		UserName: aws.String("test"),
+	}
+
+	r := request.New(aws.Config{Region: aws.String("us-east-1")}, metadata.ClientInfo{ServiceName: "iam"}, handlers, client.DefaultRetryer{}, &request.Operation{Name: "CreateLoginProfile"}, input, nil)
+	r.SetContext(WithResourceAddress(context.Background(), "aws_iam_user_login_profile.test"))
+
+	if err := r.Send(); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if strings.Count(buf.String(), "\n") != 1 {
+		t.Fatalf("expected one log line, got: %s", buf.String())
+	}
+
+	if strings.Contains(buf.String(), "hunter2") {
+		t.Fatalf("expected password to be redacted, got: %s", buf.String())
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("error decoding log line: %s", err)
+	}
+
+	expected := Entry{
+		Service:         "iam",
+		Operation:       "CreateLoginProfile",
+		Region:          "us-east-1",
+		RequestID:       "1234",
+		StatusCode:      http.StatusConflict,
+		ErrorCode:       iam.ErrCodeEntityAlreadyExistsException,
+		ResourceAddress: "aws_iam_user_login_profile.test",
+	}
+
+	got := entry
+	got.Time = expected.Time
+	got.LatencyMS = 0
+	got.Parameters = nil
+
+	if got != expected {
+		t.Errorf("got %#v, expected %#v", got, expected)
+	}
+
+	parameters, ok := entry.Parameters.(map[string]interface{})
+
+	if !ok {
+		t.Fatalf("expected parameters, got %#v", entry.Parameters)
+	}
+
+	if got, expected := parameters["Password"], RedactedValue; got != expected {
+		t.Errorf("got Password %#v, expected %#v", got, expected)
+	}
+
+	if got, expected := parameters["UserName"], "test"; got != expected {
+		t.Errorf("got UserName %#v, expected %#v", got, expected)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apilog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "api.log")
+
+	logger, err := Open(filename)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	other, err := Open(filename)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if logger != other {
+		t.Error("expected the same logger for the same file")
+	}
+
+	if err := logger.Log(&Entry{Service: "ec2", Operation: "DescribeInstances"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(b), `"operation":"DescribeInstances"`) {
+		t.Errorf("unexpected log file contents: %s", string(b))
+	}
+}
@@ -0,0 +1,138 @@
+package apilog
+
+import (
+	"io"
+	"reflect"
+	"regexp"
+	"time"
+)
+
+// RedactedValue replaces the value of sensitive fields.
+const RedactedValue = "<sensitive>"
+
+// sensitiveFieldNameRegexp matches the names of fields carrying credentials
+// that are not marked sensitive in the AWS API models.
+var sensitiveFieldNameRegexp = regexp.MustCompile(`(?i)(password|secret|token|privatekey|credentials|accesskey)`)
+
+// sensitiveFieldNameExclusions lists fields matching sensitiveFieldNameRegexp
+// that are safe to log.
+var sensitiveFieldNameExclusions = map[string]bool{
+	"AccessKeyId":        true,
+	"ClientRequestToken": true,
+	"ClientToken":        true,
+	"IdempotencyToken":   true,
+	"NextToken":          true,
+	"PaginationToken":    true,
+	"SecretArn":          true,
+	"SecretId":           true,
+}
+
+var (
+	readerType = reflect.TypeOf((*io.Reader)(nil)).Elem()
+	timeType   = reflect.TypeOf(time.Time{})
+)
+
+// Redact returns a JSON-serializable copy of AWS Go SDK API input or output
+// parameters with sensitive fields replaced by RedactedValue. Fields are
+// sensitive if marked in the AWS API models or if their names suggest credentials.
+func Redact(params interface{}) interface{} {
+	if params == nil {
+		return nil
+	}
+
+	return redact(reflect.ValueOf(params))
+}
+
+func redact(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	if v.Type().Implements(readerType) && v.Kind() != reflect.Struct {
+		if v.Kind() == reflect.Ptr && v.IsNil() || v.Kind() == reflect.Interface && v.IsNil() {
+			return nil
+		}
+
+		return "<body>"
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		return redact(v.Elem())
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface().(time.Time).UTC().Format(time.RFC3339)
+		}
+
+		m := make(map[string]interface{})
+
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			// Skip unexported fields, including the _ struct{} metadata field.
+			if field.PkgPath != "" {
+				continue
+			}
+
+			fieldValue := v.Field(i)
+
+			if (fieldValue.Kind() == reflect.Ptr || fieldValue.Kind() == reflect.Slice || fieldValue.Kind() == reflect.Map) && fieldValue.IsNil() {
+				continue
+			}
+
+			if isSensitiveField(field) {
+				m[field.Name] = RedactedValue
+				continue
+			}
+
+			m[field.Name] = redact(fieldValue)
+		}
+
+		return m
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return "<binary>"
+		}
+
+		l := make([]interface{}, v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			l[i] = redact(v.Index(i))
+		}
+
+		return l
+	case reflect.Map:
+		m := make(map[string]interface{})
+
+		iter := v.MapRange()
+		for iter.Next() {
+			key, ok := redact(iter.Key()).(string)
+
+			if !ok {
+				continue
+			}
+
+			m[key] = redact(iter.Value())
+		}
+
+		return m
+	default:
+		return v.Interface()
+	}
+}
+
+func isSensitiveField(field reflect.StructField) bool {
+	if field.Tag.Get("sensitive") == "true" {
+		return true
+	}
+
+	if sensitiveFieldNameExclusions[field.Name] {
+		return false
+	}
+
+	return sensitiveFieldNameRegexp.MatchString(field.Name)
+}
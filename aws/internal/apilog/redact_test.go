@@ -0,0 +1,107 @@
+package apilog
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestRedact(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Params   interface{}
+		Expected interface{}
+	}{
+		{
+			Name:     "nil",
+			Params:   nil,
+			Expected: nil,
+		},
+		{
+			Name: "sensitive tag",
+			Params: &sts.AssumeRoleWithWebIdentityInput{
+				RoleArn:          aws.String("arn:aws:iam::123456789012:role/test"),
+				WebIdentityToken: aws.String("token"),
+			},
+			Expected: map[string]interface{}{
+				"RoleArn":          "arn:aws:iam::123456789012:role/test",
+				"WebIdentityToken": RedactedValue,
+			},
+		},
+		{
+			Name: "sensitive name",
+			Params: &rds.CreateDBInstanceInput{
+				DBInstanceIdentifier: aws.String("test"),
+				MasterUserPassword:   aws.String("hunter2"),
+			},
+			Expected: map[string]interface{}{
+				"DBInstanceIdentifier": "test",
+				"MasterUserPassword":   RedactedValue,
+			},
+		},
+		{
+			Name: "sensitive name exclusion",
+			Params: &secretsmanager.GetSecretValueInput{
+				SecretId: aws.String("test"),
+			},
+			Expected: map[string]interface{}{
+				"SecretId": "test",
+			},
+		},
+		{
+			Name: "nested",
+			Params: &rds.ModifyDBClusterInput{
+				DBClusterIdentifier: aws.String("test"),
+				VpcSecurityGroupIds: aws.StringSlice([]string{"sg-1", "sg-2"}),
+				ScalingConfiguration: &rds.ScalingConfiguration{
+					AutoPause:   aws.Bool(true),
+					MaxCapacity: aws.Int64(4),
+				},
+			},
+			Expected: map[string]interface{}{
+				"DBClusterIdentifier": "test",
+				"VpcSecurityGroupIds": []interface{}{"sg-1", "sg-2"},
+				"ScalingConfiguration": map[string]interface{}{
+					"AutoPause":   true,
+					"MaxCapacity": int64(4),
+				},
+			},
+		},
+		{
+			Name: "body and time",
+			Params: &s3.PutObjectInput{
+				Body:    strings.NewReader("content"),
+				Bucket:  aws.String("test"),
+				Expires: aws.Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+				Metadata: map[string]*string{
+					"key": aws.String("value"),
+				},
+			},
+			Expected: map[string]interface{}{
+				"Body":    "<body>",
+				"Bucket":  "test",
+				"Expires": "2020-01-01T00:00:00Z",
+				"Metadata": map[string]interface{}{
+					"key": "value",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := Redact(testCase.Params)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
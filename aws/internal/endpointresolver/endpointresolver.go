@@ -0,0 +1,121 @@
+package endpointresolver
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// Resolver wraps an endpoints.Resolver, resolving FIPS and dual-stack
+// endpoints for all services when enabled.
+type Resolver struct {
+	Resolver     endpoints.Resolver
+	UseDualStack bool
+	UseFIPS      bool
+}
+
+// New returns a Resolver wrapping the given resolver, or the default
+// resolver if nil.
+func New(resolver endpoints.Resolver, useFIPS, useDualStack bool) *Resolver {
+	if resolver == nil {
+		resolver = endpoints.DefaultResolver()
+	}
+
+	return &Resolver{
+		Resolver:     resolver,
+		UseDualStack: useDualStack,
+		UseFIPS:      useFIPS,
+	}
+}
+
+// EndpointFor implements endpoints.Resolver.
+// Dual-stack endpoints are used only for services that support them.
+// An error is returned if FIPS endpoints are enabled and the service has no
+// FIPS endpoint in the region.
+func (r *Resolver) EndpointFor(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	if r.UseDualStack {
+		opts = append(opts, endpoints.UseDualStackOption)
+	}
+
+	if !r.UseFIPS {
+		return r.Resolver.EndpointFor(service, region, opts...)
+	}
+
+	return r.fipsEndpointFor(service, region, opts...)
+}
+
+// fipsEndpointFor resolves the FIPS endpoint of a service in a region.
+// The endpoints model represents FIPS endpoints as pseudo-regions whose
+// naming differs by service, so each known form is tried in order.
+func (r *Resolver) fipsEndpointFor(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	endpoint, err := r.Resolver.EndpointFor(service, region, opts...)
+
+	if err != nil {
+		return endpoint, err
+	}
+
+	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		if hostname, ok := globalFIPSHostnames[partition.ID()][service]; ok {
+			return endpoints.ResolvedEndpoint{
+				URL:                fmt.Sprintf("https://%s", hostname),
+				PartitionID:        partition.ID(),
+				SigningRegion:      endpoint.SigningRegion,
+				SigningName:        endpoint.SigningName,
+				SigningNameDerived: endpoint.SigningNameDerived,
+				SigningMethod:      endpoint.SigningMethod,
+			}, nil
+		}
+
+		if s, ok := partition.Services()[service]; ok {
+			serviceEndpoints := s.Endpoints()
+
+			for _, fipsRegion := range fipsRegions(region) {
+				e, ok := serviceEndpoints[fipsRegion]
+
+				if !ok {
+					continue
+				}
+
+				fipsEndpoint, err := e.ResolveEndpoint(opts...)
+
+				if err != nil {
+					continue
+				}
+
+				// Pseudo-regions such as "fips" are bound to a single region.
+				if fipsEndpoint.SigningRegion != region && fipsEndpoint.SigningRegion != endpoint.SigningRegion {
+					continue
+				}
+
+				return fipsEndpoint, nil
+			}
+		}
+	}
+
+	return endpoints.ResolvedEndpoint{}, fmt.Errorf("no FIPS endpoint found for service (%s) in region (%s), use the provider endpoints configuration to specify one", service, region)
+}
+
+// fipsRegions returns the endpoints model pseudo-regions that can identify a
+// FIPS endpoint of a regional service in the region, in order of preference.
+func fipsRegions(region string) []string {
+	return []string{
+		fmt.Sprintf("fips-%s", region),
+		fmt.Sprintf("%s-fips", region),
+		"fips",
+	}
+}
+
+// globalFIPSHostnames lists the FIPS endpoint hostnames, by partition, of
+// services without regional endpoints. The endpoints model always resolves
+// these services to their partition endpoint.
+var globalFIPSHostnames = map[string]map[string]string{
+	endpoints.AwsPartitionID: {
+		"iam":           "iam-fips.amazonaws.com",
+		"organizations": "organizations-fips.us-east-1.amazonaws.com",
+		"route53":       "route53-fips.amazonaws.com",
+		"shield":        "shield-fips.us-east-1.amazonaws.com",
+	},
+	endpoints.AwsUsGovPartitionID: {
+		"iam": "iam.us-gov.amazonaws.com",
+	},
+}
@@ -0,0 +1,118 @@
+package endpointresolver
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+func TestResolverEndpointFor(t *testing.T) {
+	testCases := []struct {
+		Name                  string
+		Service               string
+		Region                string
+		UseDualStack          bool
+		UseFIPS               bool
+		ExpectedURL           string
+		ExpectedSigningRegion string
+		ExpectError           bool
+	}{
+		{
+			Name:                  "default",
+			Service:               "ec2",
+			Region:                "us-east-1",
+			ExpectedURL:           "https://ec2.us-east-1.amazonaws.com",
+			ExpectedSigningRegion: "us-east-1",
+		},
+		{
+			Name:                  "FIPS region prefix",
+			Service:               "ec2",
+			Region:                "us-east-1",
+			UseFIPS:               true,
+			ExpectedURL:           "https://ec2-fips.us-east-1.amazonaws.com",
+			ExpectedSigningRegion: "us-east-1",
+		},
+		{
+			Name:                  "FIPS region suffix",
+			Service:               "sts",
+			Region:                "us-west-2",
+			UseFIPS:               true,
+			ExpectedURL:           "https://sts-fips.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: "us-west-2",
+		},
+		{
+			Name:                  "FIPS global service",
+			Service:               "iam",
+			Region:                "us-west-2",
+			UseFIPS:               true,
+			ExpectedURL:           "https://iam-fips.amazonaws.com",
+			ExpectedSigningRegion: "us-east-1",
+		},
+		{
+			Name:                  "FIPS GovCloud global service",
+			Service:               "iam",
+			Region:                "us-gov-west-1",
+			UseFIPS:               true,
+			ExpectedURL:           "https://iam.us-gov.amazonaws.com",
+			ExpectedSigningRegion: "us-gov-west-1",
+		},
+		{
+			Name:        "FIPS single region pseudo-region",
+			Service:     "appstream2",
+			Region:      "us-east-1",
+			UseFIPS:     true,
+			ExpectError: true,
+		},
+		{
+			Name:        "FIPS unsupported",
+			Service:     "amplify",
+			Region:      "us-east-1",
+			UseFIPS:     true,
+			ExpectError: true,
+		},
+		{
+			Name:                  "dual-stack",
+			Service:               "s3",
+			Region:                "us-west-2",
+			UseDualStack:          true,
+			ExpectedURL:           "https://s3.dualstack.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: "us-west-2",
+		},
+		{
+			Name:                  "dual-stack unsupported",
+			Service:               "ec2",
+			Region:                "us-west-2",
+			UseDualStack:          true,
+			ExpectedURL:           "https://ec2.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: "us-west-2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			resolver := New(endpoints.DefaultResolver(), testCase.UseFIPS, testCase.UseDualStack)
+
+			got, err := resolver.EndpointFor(testCase.Service, testCase.Region)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatalf("expected error, got endpoint: %s", got.URL)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.URL != testCase.ExpectedURL {
+				t.Errorf("got URL %s, expected %s", got.URL, testCase.ExpectedURL)
+			}
+
+			if got.SigningRegion != testCase.ExpectedSigningRegion {
+				t.Errorf("got signing region %s, expected %s", got.SigningRegion, testCase.ExpectedSigningRegion)
+			}
+		})
+	}
+}
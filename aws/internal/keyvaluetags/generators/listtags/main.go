@@ -40,6 +40,7 @@ var serviceNames = []string{
 	"codecommit",
 	"codedeploy",
 	"codepipeline",
+	"codestarconnections",
 	"codestarnotifications",
 	"cognitoidentity",
 	"cognitoidentityprovider",
@@ -101,6 +102,7 @@ var serviceNames = []string{
 	"rds",
 	"resourcegroups",
 	"route53",
+	"route53domains",
 	"route53resolver",
 	"sagemaker",
 	"securityhub",
@@ -111,6 +113,7 @@ var serviceNames = []string{
 	"ssm",
 	"storagegateway",
 	"swf",
+	"timestreamwrite",
 	"transfer",
 	"waf",
 	"wafregional",
@@ -34,6 +34,7 @@ var sliceServiceNames = []string{
 	"codebuild",
 	"codedeploy",
 	"codepipeline",
+	"codestarconnections",
 	"configservice",
 	"databasemigrationservice",
 	"datapipeline",
@@ -80,6 +81,7 @@ var sliceServiceNames = []string{
 	"redshift",
 	"resourcegroupstaggingapi",
 	"route53",
+	"route53domains",
 	"route53resolver",
 	"s3",
 	"s3control",
@@ -91,8 +93,10 @@ var sliceServiceNames = []string{
 	"sfn",
 	"sns",
 	"ssm",
+	"ssoadmin",
 	"storagegateway",
 	"swf",
+	"timestreamwrite",
 	"transfer",
 	"waf",
 	"wafregional",
@@ -126,6 +130,7 @@ var mapServiceNames = []string{
 	"kinesisvideo",
 	"imagebuilder",
 	"lambda",
+	"macie2",
 	"mediaconnect",
 	"mediaconvert",
 	"medialive",
@@ -41,6 +41,7 @@ var serviceNames = []string{
 	"codecommit",
 	"codedeploy",
 	"codepipeline",
+	"codestarconnections",
 	"codestarnotifications",
 	"cognitoidentity",
 	"cognitoidentityprovider",
@@ -88,6 +89,7 @@ var serviceNames = []string{
 	"lambda",
 	"licensemanager",
 	"lightsail",
+	"macie2",
 	"mediaconnect",
 	"mediaconvert",
 	"medialive",
@@ -106,6 +108,7 @@ var serviceNames = []string{
 	"redshift",
 	"resourcegroups",
 	"route53",
+	"route53domains",
 	"route53resolver",
 	"sagemaker",
 	"secretsmanager",
@@ -118,6 +121,7 @@ var serviceNames = []string{
 	"storagegateway",
 	"swf",
 	"synthetics",
+	"timestreamwrite",
 	"transfer",
 	"waf",
 	"wafregional",
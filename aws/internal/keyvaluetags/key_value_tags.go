@@ -18,16 +18,183 @@ import (
 )
 
 const (
-	AwsTagKeyPrefix              = `aws:`
-	ElasticbeanstalkTagKeyPrefix = `elasticbeanstalk:`
-	NameTagKey                   = `Name`
-	RdsTagKeyPrefix              = `rds:`
+	AwsTagKeyPrefix                             = `aws:`
+	ElasticbeanstalkTagKeyPrefix                = `elasticbeanstalk:`
+	NameTagKey                                  = `Name`
+	RdsTagKeyPrefix                             = `rds:`
+	ServerlessApplicationRepositoryTagKeyPrefix = `serverlessrepo:`
 )
 
+// DefaultConfig contains tags to default across all resources.
+type DefaultConfig struct {
+	Tags KeyValueTags
+}
+
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	KeyRegexps  []*regexp.Regexp
+	Rules       []*IgnoreRule
+}
+
+// IgnoreRule removes resource tags matching both its key and value regular
+// expressions. A nil regular expression matches any key or value.
+// A rule with ResourceTypes only applies to those Terraform resource types.
+type IgnoreRule struct {
+	KeyRegexp     *regexp.Regexp
+	ValueRegexp   *regexp.Regexp
+	ResourceTypes []string
+}
+
+// Matches returns true if the rule matches the given tag key and value.
+func (rule *IgnoreRule) Matches(key string, value *string) bool {
+	if rule.KeyRegexp != nil && !rule.KeyRegexp.MatchString(key) {
+		return false
+	}
+
+	if rule.ValueRegexp != nil && (value == nil || !rule.ValueRegexp.MatchString(*value)) {
+		return false
+	}
+
+	return true
+}
+
+// HasResourceTypes returns true if any rule of the configuration is
+// limited to specific resource types.
+func (config *IgnoreConfig) HasResourceTypes() bool {
+	if config == nil {
+		return false
+	}
+
+	for _, rule := range config.Rules {
+		if len(rule.ResourceTypes) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ForResourceType returns the configuration applicable to a Terraform resource type.
+// Rules limited to other resource types are removed.
+func (config *IgnoreConfig) ForResourceType(resourceType string) *IgnoreConfig {
+	if !config.HasResourceTypes() {
+		return config
+	}
+
+	result := &IgnoreConfig{
+		Keys:        config.Keys,
+		KeyPrefixes: config.KeyPrefixes,
+		KeyRegexps:  config.KeyRegexps,
+	}
+
+	for _, rule := range config.Rules {
+		if len(rule.ResourceTypes) == 0 {
+			result.Rules = append(result.Rules, rule)
+			continue
+		}
+
+		for _, t := range rule.ResourceTypes {
+			if t == resourceType {
+				result.Rules = append(result.Rules, &IgnoreRule{
+					KeyRegexp:   rule.KeyRegexp,
+					ValueRegexp: rule.ValueRegexp,
+				})
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// RequiredConfig contains tag keys and values required across all resources.
+type RequiredConfig struct {
+	Keys          KeyValueTags
+	AllowedValues map[string][]string
+}
+
+// MissingKeys returns the sorted required tag keys not present in the given tags.
+func (rc *RequiredConfig) MissingKeys(tags KeyValueTags) []string {
+	if rc == nil || New(tags.Keys()).ContainsAll(rc.Keys) {
+		return nil
+	}
+
+	var result []string
+
+	for _, k := range rc.Keys.Keys() {
+		if !tags.KeyExists(k) {
+			result = append(result, k)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// InvalidKeys returns the sorted tag keys whose values are not allowed.
+// Keys not present in the given tags are not reported.
+func (rc *RequiredConfig) InvalidKeys(tags KeyValueTags) []string {
+	if rc == nil {
+		return nil
+	}
+
+	var result []string
+
+	for k, allowedValues := range rc.AllowedValues {
+		if !tags.KeyExists(k) {
+			continue
+		}
+
+		valid := false
+
+		for _, allowedValue := range allowedValues {
+			if tags.ContainsAll(New(map[string]string{k: allowedValue})) {
+				valid = true
+				break
+			}
+		}
+
+		if !valid {
+			result = append(result, k)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// MergeTags returns the result of keyvaluetags.Merge() on the given
+// DefaultConfig.Tags with KeyValueTags provided as an argument,
+// overriding the value of any tag with a matching key.
+func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	return dc.Tags.Merge(tags)
+}
+
+// TagsEqual returns true if the given configuration's Tags
+// are equal to those passed in as an argument;
+// otherwise returns false.
+func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
+	if dc == nil || dc.Tags == nil {
+		return tags == nil
+	}
+
+	if tags == nil {
+		return false
+	}
+
+	if len(tags) == 0 || len(dc.Tags) == 0 {
+		return false
+	}
+
+	return dc.Tags.ContainsAll(tags) && tags.ContainsAll(dc.Tags)
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -57,6 +224,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreRegexps(config.KeyRegexps)
+	result = result.IgnoreRules(config.Rules)
 
 	return result
 }
@@ -108,6 +277,65 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreRegexps returns tag keys not matching any of the regular expressions.
+func (tags KeyValueTags) IgnoreRegexps(ignoreTagRegexps []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, ignoreTagRegexp := range ignoreTagRegexps {
+			if ignoreTagRegexp.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreRules returns tags not matching any of the rules.
+// Rules limited to specific resource types are not applied,
+// see IgnoreConfig.ForResourceType.
+func (tags KeyValueTags) IgnoreRules(ignoreRules []*IgnoreRule) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var value *string
+		if v != nil {
+			value = v.Value
+		}
+
+		var ignore bool
+
+		for _, ignoreRule := range ignoreRules {
+			if len(ignoreRule.ResourceTypes) > 0 {
+				continue
+			}
+
+			if ignoreRule.Matches(k, value) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreRDS returns non-AWS and non-RDS tag keys.
 func (tags KeyValueTags) IgnoreRds() KeyValueTags {
 	result := make(KeyValueTags)
@@ -127,6 +355,25 @@ func (tags KeyValueTags) IgnoreRds() KeyValueTags {
 	return result
 }
 
+// IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
+func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if strings.HasPrefix(k, AwsTagKeyPrefix) {
+			continue
+		}
+
+		if strings.HasPrefix(k, ServerlessApplicationRepositoryTagKeyPrefix) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // Ignore returns non-matching tag keys.
 func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
@@ -307,6 +554,25 @@ func (tags KeyValueTags) Only(onlyTags KeyValueTags) KeyValueTags {
 	return result
 }
 
+// RemoveDefaultConfig returns tags not present in the given DefaultConfig.
+// Tags with a matching key but a different value are kept, as the
+// resource-level value overrides the provider-level default.
+func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if defaultVal, ok := dc.Tags[k]; !ok || !v.Equal(defaultVal) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
 // Removed returns tags removed.
 func (tags KeyValueTags) Removed(newTags KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
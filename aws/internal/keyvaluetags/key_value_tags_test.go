@@ -1,9 +1,354 @@
 package keyvaluetags
 
 import (
+	"reflect"
+	"regexp"
 	"testing"
 )
 
+func TestDefaultConfigMergeTags(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name: "empty config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no tags",
+			tags: New(map[string]string{}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "keys all matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys some matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys none matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys overlapping with different values",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value0",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.MergeTags(testCase.tags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestDefaultConfigTagsEqual(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          bool
+	}{
+		{
+			name:          "empty config",
+			tags:          New(map[string]string{}),
+			defaultConfig: &DefaultConfig{},
+			want:          false,
+		},
+		{
+			name:          "no config",
+			tags:          New(map[string]string{}),
+			defaultConfig: nil,
+			want:          false,
+		},
+		{
+			name: "empty tags",
+			tags: New(map[string]string{}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{}),
+			},
+			want: false,
+		},
+		{
+			name: "no tags",
+			tags: nil,
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: false,
+		},
+		{
+			name: "equal",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: true,
+		},
+		{
+			name: "subset",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: false,
+		},
+		{
+			name: "different values",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value0",
+				}),
+			},
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.TagsEqual(testCase.tags)
+
+			if got != testCase.want {
+				t.Errorf("unexpected TagsEqual: %t", got)
+			}
+		})
+	}
+}
+
+func TestRequiredConfigMissingKeys(t *testing.T) {
+	testCases := []struct {
+		name           string
+		tags           KeyValueTags
+		requiredConfig *RequiredConfig
+		want           []string
+	}{
+		{
+			name:           "no config",
+			tags:           New(map[string]string{}),
+			requiredConfig: nil,
+			want:           nil,
+		},
+		{
+			name:           "empty config",
+			tags:           New(map[string]string{}),
+			requiredConfig: &RequiredConfig{},
+			want:           nil,
+		},
+		{
+			name: "all present",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{"key1", "key2"}),
+			},
+			want: nil,
+		},
+		{
+			name: "empty value",
+			tags: New(map[string]string{
+				"key1": "",
+			}),
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{"key1"}),
+			},
+			want: nil,
+		},
+		{
+			name: "some missing",
+			tags: New(map[string]string{
+				"key2": "value2",
+			}),
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{"key3", "key2", "key1"}),
+			},
+			want: []string{"key1", "key3"},
+		},
+		{
+			name: "no tags",
+			tags: nil,
+			requiredConfig: &RequiredConfig{
+				Keys: New([]string{"key1"}),
+			},
+			want: []string{"key1"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.requiredConfig.MissingKeys(testCase.tags)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, expected %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestRequiredConfigInvalidKeys(t *testing.T) {
+	testCases := []struct {
+		name           string
+		tags           KeyValueTags
+		requiredConfig *RequiredConfig
+		want           []string
+	}{
+		{
+			name:           "no config",
+			tags:           New(map[string]string{}),
+			requiredConfig: nil,
+			want:           nil,
+		},
+		{
+			name: "allowed values",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			requiredConfig: &RequiredConfig{
+				AllowedValues: map[string][]string{
+					"key1": {"value0", "value1"},
+					"key2": {"value2"},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "key not present",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			requiredConfig: &RequiredConfig{
+				AllowedValues: map[string][]string{
+					"key2": {"value2"},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "invalid values",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			requiredConfig: &RequiredConfig{
+				AllowedValues: map[string][]string{
+					"key1": {"value0"},
+					"key2": {"value2"},
+					"key3": {"VALUE3"},
+				},
+			},
+			want: []string{"key1", "key3"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.requiredConfig.InvalidKeys(testCase.tags)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, expected %v", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAws(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -246,6 +591,80 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexps",
+			tags: New(map[string]string{
+				"key1":       "value1",
+				"scan:2020":  "value2",
+				"scan:daily": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexps: []*regexp.Regexp{
+					regexp.MustCompile(`^scan:`),
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "rule value",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "managed-by-scanner",
+				"key3": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Rules: []*IgnoreRule{
+					{
+						ValueRegexp: regexp.MustCompile(`^managed-by-`),
+					},
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "rule key and value",
+			tags: New(map[string]string{
+				"key1":     "value1",
+				"scanned":  "true",
+				"scanned2": "false",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Rules: []*IgnoreRule{
+					{
+						KeyRegexp:   regexp.MustCompile(`^scanned`),
+						ValueRegexp: regexp.MustCompile(`^true$`),
+					},
+				},
+			},
+			want: map[string]string{
+				"key1":     "value1",
+				"scanned2": "false",
+			},
+		},
+		{
+			name: "rule resource types not applied",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Rules: []*IgnoreRule{
+					{
+						KeyRegexp:     regexp.MustCompile(`^key1$`),
+						ResourceTypes: []string{"aws_instance"},
+					},
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -257,6 +676,78 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 	}
 }
 
+func TestIgnoreConfigForResourceType(t *testing.T) {
+	ignoreConfig := &IgnoreConfig{
+		Keys: New([]string{"key1"}),
+		Rules: []*IgnoreRule{
+			{
+				KeyRegexp: regexp.MustCompile(`^key2$`),
+			},
+			{
+				KeyRegexp:     regexp.MustCompile(`^key3$`),
+				ResourceTypes: []string{"aws_instance", "aws_vpc"},
+			},
+			{
+				KeyRegexp:     regexp.MustCompile(`^key4$`),
+				ResourceTypes: []string{"aws_subnet"},
+			},
+		},
+	}
+
+	tags := New(map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+		"key3": "value3",
+		"key4": "value4",
+		"key5": "value5",
+	})
+
+	testCases := []struct {
+		name         string
+		resourceType string
+		want         map[string]string
+	}{
+		{
+			name:         "matching resource type",
+			resourceType: "aws_vpc",
+			want: map[string]string{
+				"key4": "value4",
+				"key5": "value5",
+			},
+		},
+		{
+			name:         "other resource type",
+			resourceType: "aws_security_group",
+			want: map[string]string{
+				"key3": "value3",
+				"key4": "value4",
+				"key5": "value5",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := tags.IgnoreConfig(ignoreConfig.ForResourceType(testCase.resourceType))
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+
+	if !ignoreConfig.HasResourceTypes() {
+		t.Error("expected configuration to have resource types")
+	}
+
+	if ignoreConfig.ForResourceType("aws_vpc").HasResourceTypes() {
+		t.Error("expected resource type configuration to have no resource types")
+	}
+
+	var nilConfig *IgnoreConfig
+	if nilConfig.ForResourceType("aws_vpc") != nil {
+		t.Error("expected nil configuration for resource type of nil configuration")
+	}
+}
+
 func TestKeyValueTagsIgnoreElasticbeanstalk(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -458,6 +949,63 @@ func TestKeyValueTagsIgnoreRds(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreServerlessApplicationRepository(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1":        "value1",
+				"serverlessrepo:applicationId":   "value2",
+				"serverlessrepo:semanticVersion": "value3",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1":      "value1",
+				"key2":                         "value2",
+				"serverlessrepo:applicationId": "value3",
+				"key4":                         "value4",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+				"key4": "value4",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreServerlessApplicationRepository()
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnore(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -1217,6 +1765,99 @@ func TestKeyValueTagsOnly(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          map[string]string
+	}{
+		{
+			name: "empty config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no tags",
+			tags: New(map[string]string{}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "keys all matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "keys some matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys matching with different values",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value0",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.RemoveDefaultConfig(testCase.defaultConfig)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsRemoved(t *testing.T) {
 	testCases := []struct {
 		name    string
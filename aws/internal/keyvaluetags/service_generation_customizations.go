@@ -30,6 +30,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/codecommit"
 	"github.com/aws/aws-sdk-go/service/codedeploy"
 	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
 	"github.com/aws/aws-sdk-go/service/codestarnotifications"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
@@ -78,6 +79,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/mediaconnect"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/aws/aws-sdk-go/service/medialive"
@@ -97,6 +99,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
@@ -109,6 +112,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/swf"
 	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
@@ -173,6 +177,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(codedeploy.New)
 	case "codepipeline":
 		funcType = reflect.TypeOf(codepipeline.New)
+	case "codestarconnections":
+		funcType = reflect.TypeOf(codestarconnections.New)
 	case "codestarnotifications":
 		funcType = reflect.TypeOf(codestarnotifications.New)
 	case "cognitoidentity":
@@ -269,6 +275,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(licensemanager.New)
 	case "lightsail":
 		funcType = reflect.TypeOf(lightsail.New)
+	case "macie2":
+		funcType = reflect.TypeOf(macie2.New)
 	case "mediaconnect":
 		funcType = reflect.TypeOf(mediaconnect.New)
 	case "mediaconvert":
@@ -307,6 +315,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(resourcegroupstaggingapi.New)
 	case "route53":
 		funcType = reflect.TypeOf(route53.New)
+	case "route53domains":
+		funcType = reflect.TypeOf(route53domains.New)
 	case "route53resolver":
 		funcType = reflect.TypeOf(route53resolver.New)
 	case "sagemaker":
@@ -331,6 +341,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(swf.New)
 	case "synthetics":
 		funcType = reflect.TypeOf(synthetics.New)
+	case "timestreamwrite":
+		funcType = reflect.TypeOf(timestreamwrite.New)
 	case "transfer":
 		funcType = reflect.TypeOf(transfer.New)
 	case "waf":
@@ -409,6 +421,8 @@ func ServiceListTagsFunction(serviceName string) string {
 		return "DescribeTags"
 	case "resourcegroups":
 		return "GetTags"
+	case "route53domains":
+		return "ListTagsForDomain"
 	case "sagemaker":
 		return "ListTags"
 	case "sqs":
@@ -502,6 +516,8 @@ func ServiceListTagsOutputTagsField(serviceName string) string {
 		return "TagList"
 	case "route53":
 		return "ResourceTagSet.Tags"
+	case "route53domains":
+		return "TagList"
 	case "ssm":
 		return "TagList"
 	case "waf":
@@ -604,6 +620,8 @@ func ServiceTagFunction(serviceName string) string {
 		return "Tag"
 	case "route53":
 		return "ChangeTagsForResource"
+	case "route53domains":
+		return "UpdateTagsForDomain"
 	case "sagemaker":
 		return "AddTags"
 	case "sqs":
@@ -718,6 +736,8 @@ func ServiceTagInputIdentifierField(serviceName string) string {
 		return "Arn"
 	case "route53":
 		return "ResourceId"
+	case "route53domains":
+		return "DomainName"
 	case "secretsmanager":
 		return "SecretId"
 	case "servicediscovery":
@@ -728,6 +748,8 @@ func ServiceTagInputIdentifierField(serviceName string) string {
 		return "ResourceId"
 	case "storagegateway":
 		return "ResourceARN"
+	case "timestreamwrite":
+		return "ResourceARN"
 	case "transfer":
 		return "Arn"
 	case "waf":
@@ -776,6 +798,8 @@ func ServiceTagInputTagsField(serviceName string) string {
 		return "TagsModel"
 	case "route53":
 		return "AddTags"
+	case "route53domains":
+		return "TagsToUpdate"
 	default:
 		return "Tags"
 	}
@@ -957,6 +981,8 @@ func ServiceUntagFunction(serviceName string) string {
 		return "Untag"
 	case "route53":
 		return "ChangeTagsForResource"
+	case "route53domains":
+		return "DeleteTagsForDomain"
 	case "sagemaker":
 		return "DeleteTags"
 	case "sqs":
@@ -1033,6 +1059,8 @@ func ServiceUntagInputTagsField(serviceName string) string {
 		return "Keys"
 	case "route53":
 		return "RemoveTagKeys"
+	case "route53domains":
+		return "TagsToDelete"
 	default:
 		return "TagKeys"
 	}
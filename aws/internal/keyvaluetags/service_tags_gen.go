@@ -22,6 +22,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/aws/aws-sdk-go/service/codedeploy"
 	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go/service/datapipeline"
@@ -68,6 +69,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3control"
@@ -79,8 +81,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafv2"
@@ -331,6 +335,16 @@ func LambdaKeyValueTags(tags map[string]*string) KeyValueTags {
 	return New(tags)
 }
 
+// Macie2Tags returns macie2 service tags.
+func (tags KeyValueTags) Macie2Tags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
+
+// Macie2KeyValueTags creates KeyValueTags from macie2 service tags.
+func Macie2KeyValueTags(tags map[string]*string) KeyValueTags {
+	return New(tags)
+}
+
 // MediaconnectTags returns mediaconnect service tags.
 func (tags KeyValueTags) MediaconnectTags() map[string]*string {
 	return aws.StringMap(tags.Map())
@@ -1023,6 +1037,33 @@ func CodepipelineKeyValueTags(tags []*codepipeline.Tag) KeyValueTags {
 	return New(m)
 }
 
+// CodestarconnectionsTags returns codestarconnections service tags.
+func (tags KeyValueTags) CodestarconnectionsTags() []*codestarconnections.Tag {
+	result := make([]*codestarconnections.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &codestarconnections.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// CodestarconnectionsKeyValueTags creates KeyValueTags from codestarconnections service tags.
+func CodestarconnectionsKeyValueTags(tags []*codestarconnections.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // ConfigserviceTags returns configservice service tags.
 func (tags KeyValueTags) ConfigserviceTags() []*configservice.Tag {
 	result := make([]*configservice.Tag, 0, len(tags))
@@ -2297,6 +2338,33 @@ func Route53KeyValueTags(tags []*route53.Tag) KeyValueTags {
 	return New(m)
 }
 
+// Route53domainsTags returns route53domains service tags.
+func (tags KeyValueTags) Route53domainsTags() []*route53domains.Tag {
+	result := make([]*route53domains.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &route53domains.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// Route53domainsKeyValueTags creates KeyValueTags from route53domains service tags.
+func Route53domainsKeyValueTags(tags []*route53domains.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // Route53resolverTags returns route53resolver service tags.
 func (tags KeyValueTags) Route53resolverTags() []*route53resolver.Tag {
 	result := make([]*route53resolver.Tag, 0, len(tags))
@@ -2594,6 +2662,33 @@ func SsmKeyValueTags(tags []*ssm.Tag) KeyValueTags {
 	return New(m)
 }
 
+// SsoadminTags returns ssoadmin service tags.
+func (tags KeyValueTags) SsoadminTags() []*ssoadmin.Tag {
+	result := make([]*ssoadmin.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &ssoadmin.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// SsoadminKeyValueTags creates KeyValueTags from ssoadmin service tags.
+func SsoadminKeyValueTags(tags []*ssoadmin.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // StoragegatewayTags returns storagegateway service tags.
 func (tags KeyValueTags) StoragegatewayTags() []*storagegateway.Tag {
 	result := make([]*storagegateway.Tag, 0, len(tags))
@@ -2648,6 +2743,33 @@ func SwfKeyValueTags(tags []*swf.ResourceTag) KeyValueTags {
 	return New(m)
 }
 
+// TimestreamwriteTags returns timestreamwrite service tags.
+func (tags KeyValueTags) TimestreamwriteTags() []*timestreamwrite.Tag {
+	result := make([]*timestreamwrite.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &timestreamwrite.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// TimestreamwriteKeyValueTags creates KeyValueTags from timestreamwrite service tags.
+func TimestreamwriteKeyValueTags(tags []*timestreamwrite.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // TransferTags returns transfer service tags.
 func (tags KeyValueTags) TransferTags() []*transfer.Tag {
 	result := make([]*transfer.Tag, 0, len(tags))
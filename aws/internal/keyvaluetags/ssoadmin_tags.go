@@ -0,0 +1,77 @@
+// +build !generate
+
+package keyvaluetags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+)
+
+// Custom SSO Admin tag service functions using the same format as generated code.
+// Unlike most services, SSO Admin requires the SSO Instance ARN alongside the resource ARN.
+
+// SsoadminListTags lists ssoadmin service tags.
+// The identifier is the resource ARN.
+func SsoadminListTags(conn *ssoadmin.SSOAdmin, identifier string, instanceArn string) (KeyValueTags, error) {
+	input := &ssoadmin.ListTagsForResourceInput{
+		InstanceArn: aws.String(instanceArn),
+		ResourceArn: aws.String(identifier),
+	}
+
+	var tags []*ssoadmin.Tag
+
+	err := conn.ListTagsForResourcePages(input, func(page *ssoadmin.ListTagsForResourceOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		tags = append(tags, page.Tags...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return SsoadminKeyValueTags(tags), nil
+}
+
+// SsoadminUpdateTags updates ssoadmin service tags.
+// The identifier is the resource ARN.
+func SsoadminUpdateTags(conn *ssoadmin.SSOAdmin, identifier string, instanceArn string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &ssoadmin.UntagResourceInput{
+			InstanceArn: aws.String(instanceArn),
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.IgnoreAws().Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &ssoadmin.TagResourceInput{
+			InstanceArn: aws.String(instanceArn),
+			ResourceArn: aws.String(identifier),
+			Tags:        updatedTags.IgnoreAws().SsoadminTags(),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
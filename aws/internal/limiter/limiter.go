@@ -0,0 +1,161 @@
+package limiter
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Limit configures the request limits of a service.
+// Zero values are unlimited.
+type Limit struct {
+	// RequestsPerSecond is the sustained request rate.
+	RequestsPerSecond float64
+
+	// MaxInFlight is the maximum number of concurrent requests.
+	MaxInFlight int
+}
+
+// TokenBucket limits the rate of operations, allowing bursts of up to one
+// second of operations.
+type TokenBucket struct {
+	mu sync.Mutex
+
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+// NewTokenBucket returns a full TokenBucket with the given rate, in operations per second.
+func NewTokenBucket(rate float64) *TokenBucket {
+	burst := rate
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &TokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		now:    time.Now,
+		sleep:  time.Sleep,
+	}
+}
+
+// Wait blocks until an operation can be performed.
+func (b *TokenBucket) Wait() {
+	b.mu.Lock()
+	now := b.now()
+
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+
+	b.last = now
+	b.tokens--
+
+	// Reserve the token, then wait for it outside the lock.
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.mu.Unlock()
+
+	if delay > 0 {
+		b.sleep(delay)
+	}
+}
+
+// Semaphore limits the number of concurrent operations.
+type Semaphore chan struct{}
+
+// NewSemaphore returns a Semaphore allowing limit concurrent operations.
+func NewSemaphore(limit int) Semaphore {
+	return make(Semaphore, limit)
+}
+
+// Acquire blocks until an operation can be started.
+func (s Semaphore) Acquire() {
+	s <- struct{}{}
+}
+
+// Release marks an operation as completed.
+func (s Semaphore) Release() {
+	// Make Release non-blocking, which can happen if Acquire was never called.
+	select {
+	case <-s:
+	default:
+	}
+}
+
+// Limiter limits the requests of each service.
+type Limiter struct {
+	buckets    map[string]*TokenBucket
+	semaphores map[string]Semaphore
+}
+
+// New returns a Limiter for the limits, keyed by AWS Go SDK service name, e.g. ec2.
+func New(limits map[string]Limit) *Limiter {
+	l := &Limiter{
+		buckets:    make(map[string]*TokenBucket),
+		semaphores: make(map[string]Semaphore),
+	}
+
+	for service, limit := range limits {
+		if limit.RequestsPerSecond > 0 {
+			l.buckets[service] = NewTokenBucket(limit.RequestsPerSecond)
+		}
+
+		if limit.MaxInFlight > 0 {
+			l.semaphores[service] = NewSemaphore(limit.MaxInFlight)
+		}
+	}
+
+	return l
+}
+
+// Wait blocks until a request to the service can be sent.
+func (l *Limiter) Wait(service string) {
+	if bucket, ok := l.buckets[service]; ok {
+		bucket.Wait()
+	}
+
+	if semaphore, ok := l.semaphores[service]; ok {
+		semaphore.Acquire()
+	}
+}
+
+// Done marks a request to the service as completed.
+func (l *Limiter) Done(service string) {
+	if semaphore, ok := l.semaphores[service]; ok {
+		semaphore.Release()
+	}
+}
+
+// AddHandlers adds the handlers limiting requests to the handlers of a session
+// or client. Limits apply to each attempt of a request, so that requests
+// waiting to be retried do not count as in flight.
+func (l *Limiter) AddHandlers(handlers *request.Handlers) {
+	handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.LimiterWaitHandler",
+		Fn: func(r *request.Request) {
+			l.Wait(r.ClientInfo.ServiceName)
+		},
+	})
+
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.LimiterDoneHandler",
+		Fn: func(r *request.Request) {
+			l.Done(r.ClientInfo.ServiceName)
+		},
+	})
+}
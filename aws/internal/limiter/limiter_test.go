@@ -0,0 +1,173 @@
+package limiter
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+type testClock struct {
+	now   time.Time
+	slept time.Duration
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Sleep(d time.Duration) {
+	c.slept += d
+	c.now = c.now.Add(d)
+}
+
+func TestTokenBucket(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Rate          float64
+		Operations    int
+		ExpectedDelay time.Duration
+	}{
+		{
+			Name:          "burst",
+			Rate:          10,
+			Operations:    10,
+			ExpectedDelay: 0,
+		},
+		{
+			Name:          "sustained",
+			Rate:          10,
+			Operations:    30,
+			ExpectedDelay: 2 * time.Second,
+		},
+		{
+			Name:          "less than one per second",
+			Rate:          0.5,
+			Operations:    3,
+			ExpectedDelay: 4 * time.Second,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			clock := &testClock{now: time.Unix(0, 0)}
+			bucket := NewTokenBucket(testCase.Rate)
+			bucket.now = clock.Now
+			bucket.sleep = clock.Sleep
+
+			for i := 0; i < testCase.Operations; i++ {
+				bucket.Wait()
+			}
+
+			if got, expected := clock.slept, testCase.ExpectedDelay; got < expected-time.Millisecond || got > expected+time.Millisecond {
+				t.Errorf("got delay %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestSemaphore(t *testing.T) {
+	semaphore := NewSemaphore(2)
+
+	var inFlight, maxInFlight int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			semaphore.Acquire()
+			defer semaphore.Release()
+
+			n := atomic.AddInt32(&inFlight, 1)
+
+			for {
+				max := atomic.LoadInt32(&maxInFlight)
+
+				if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+					break
+				}
+			}
+
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+		}()
+	}
+
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&maxInFlight); got > 2 {
+		t.Errorf("got %d concurrent operations, expected at most 2", got)
+	}
+
+	// Releasing without acquiring does not block.
+	semaphore.Release()
+}
+
+func TestLimiterAddHandlers(t *testing.T) {
+	l := New(map[string]Limit{
+		"ec2": {
+			MaxInFlight: 1,
+		},
+	})
+
+	handlers := request.Handlers{}
+	l.AddHandlers(&handlers)
+
+	var sent int
+	handlers.Send.PushBack(func(r *request.Request) {
+		sent++
+
+		if got := len(l.semaphores["ec2"]); got != 1 {
+			t.Errorf("got %d requests in flight, expected 1", got)
+		}
+	})
+
+	for i := 0; i < 3; i++ {
+		r := request.New(aws.Config{}, metadata.ClientInfo{ServiceName: "ec2"}, handlers, client.DefaultRetryer{}, &request.Operation{Name: "DescribeInstances"}, nil, nil)
+
+		if err := r.Send(); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if sent != 3 {
+		t.Errorf("got %d requests sent, expected 3", sent)
+	}
+
+	if got := len(l.semaphores["ec2"]); got != 0 {
+		t.Errorf("got %d requests in flight, expected 0", got)
+	}
+}
+
+func TestLimiterUnlimitedService(t *testing.T) {
+	l := New(map[string]Limit{
+		"ec2": {
+			RequestsPerSecond: 1,
+			MaxInFlight:       1,
+		},
+	})
+
+	done := make(chan struct{})
+
+	go func() {
+		for i := 0; i < 100; i++ {
+			l.Wait("iam")
+		}
+
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected requests to a service without limits not to block")
+	}
+}
@@ -0,0 +1,219 @@
+package retryer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// rateLimiterMinRate is the lowest request rate, in requests per second,
+	// a throttled service is limited to.
+	rateLimiterMinRate = 0.5
+
+	// rateLimiterDecrease is the factor the request rate is reduced by after
+	// a request is throttled.
+	rateLimiterDecrease = 0.7
+
+	// rateLimiterIncrease is the request rate, in requests per second,
+	// recovered after each successful request.
+	rateLimiterIncrease = 0.5
+)
+
+// RateLimiter is an adaptive client-side token bucket rate limiter.
+// It is disabled until a request is throttled, then reduces the request
+// rate multiplicatively on each throttled request and increases it additively
+// on each successful request until it is disabled again.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	enabled bool
+	rate    float64
+	tokens  float64
+	last    time.Time
+
+	// measuredRate is the observed request rate, in requests per second.
+	measuredRate  float64
+	measuredCount int
+	measuredStart time.Time
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+// NewRateLimiter returns a new, disabled, RateLimiter.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		now:   time.Now,
+		sleep: time.Sleep,
+	}
+}
+
+// Wait blocks until a request can be sent.
+func (l *RateLimiter) Wait() {
+	l.mu.Lock()
+	now := l.now()
+	l.measure(now)
+
+	if !l.enabled {
+		l.mu.Unlock()
+		return
+	}
+
+	l.refill(now)
+	l.tokens--
+
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay > 0 {
+		l.sleep(delay)
+	}
+}
+
+// Throttled reduces the request rate after a request is throttled.
+func (l *RateLimiter) Throttled() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	if !l.enabled {
+		l.enabled = true
+		l.rate = l.measuredRate
+		l.tokens = 0
+		l.last = now
+	} else {
+		l.refill(now)
+	}
+
+	l.rate *= rateLimiterDecrease
+
+	if l.rate < rateLimiterMinRate {
+		l.rate = rateLimiterMinRate
+	}
+}
+
+// Succeeded recovers the request rate after a request succeeds.
+func (l *RateLimiter) Succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.enabled {
+		return
+	}
+
+	l.refill(l.now())
+	l.rate += rateLimiterIncrease
+
+	// Stop limiting once the rate exceeds the highest observed request rate.
+	if l.rate > 2*l.measuredRate && l.rate > 2*rateLimiterMinRate {
+		l.enabled = false
+	}
+}
+
+// Rate returns the current request rate limit, in requests per second,
+// or 0 if the rate is not limited.
+func (l *RateLimiter) Rate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.enabled {
+		return 0
+	}
+
+	return l.rate
+}
+
+func (l *RateLimiter) refill(now time.Time) {
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	l.last = now
+
+	// Allow bursts of at most one second of requests.
+	if l.tokens > l.rate {
+		l.tokens = l.rate
+	}
+}
+
+func (l *RateLimiter) measure(now time.Time) {
+	if l.measuredStart.IsZero() {
+		l.measuredStart = now
+	}
+
+	l.measuredCount++
+
+	if elapsed := now.Sub(l.measuredStart); elapsed >= time.Second {
+		rate := float64(l.measuredCount) / elapsed.Seconds()
+
+		if rate > l.measuredRate {
+			l.measuredRate = rate
+		}
+
+		l.measuredCount = 0
+		l.measuredStart = now
+	}
+
+	if l.measuredRate < rateLimiterMinRate {
+		l.measuredRate = rateLimiterMinRate
+	}
+}
+
+// RateLimiters holds a RateLimiter per AWS Go SDK service name.
+type RateLimiters struct {
+	mu       sync.Mutex
+	limiters map[string]*RateLimiter
+}
+
+// NewRateLimiters returns a new RateLimiters.
+func NewRateLimiters() *RateLimiters {
+	return &RateLimiters{
+		limiters: make(map[string]*RateLimiter),
+	}
+}
+
+// Get returns the RateLimiter of a service, creating it if necessary.
+func (l *RateLimiters) Get(service string) *RateLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limiter, ok := l.limiters[service]
+
+	if !ok {
+		limiter = NewRateLimiter()
+		l.limiters[service] = limiter
+	}
+
+	return limiter
+}
+
+// AddHandlers adds the handlers limiting the request rate of each service.
+func (l *RateLimiters) AddHandlers(handlers *request.Handlers) {
+	handlers.Send.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimiterSendHandler",
+		Fn: func(r *request.Request) {
+			l.Get(r.ClientInfo.ServiceName).Wait()
+		},
+	})
+
+	handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimiterRetryHandler",
+		Fn: func(r *request.Request) {
+			if r.IsErrorThrottle() {
+				l.Get(r.ClientInfo.ServiceName).Throttled()
+			}
+		},
+	})
+
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimiterCompleteHandler",
+		Fn: func(r *request.Request) {
+			if r.Error == nil {
+				l.Get(r.ClientInfo.ServiceName).Succeeded()
+			}
+		},
+	})
+}
@@ -0,0 +1,125 @@
+package retryer
+
+import (
+	"testing"
+	"time"
+)
+
+type testClock struct {
+	now   time.Time
+	slept time.Duration
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Sleep(d time.Duration) {
+	c.slept += d
+	c.now = c.now.Add(d)
+}
+
+func newTestRateLimiter(clock *testClock) *RateLimiter {
+	limiter := NewRateLimiter()
+	limiter.now = clock.Now
+	limiter.sleep = clock.Sleep
+
+	return limiter
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	clock := &testClock{now: time.Unix(0, 0)}
+	limiter := newTestRateLimiter(clock)
+
+	for i := 0; i < 100; i++ {
+		limiter.Wait()
+	}
+
+	if clock.slept != 0 {
+		t.Errorf("expected no delay, got %s", clock.slept)
+	}
+
+	if got := limiter.Rate(); got != 0 {
+		t.Errorf("expected no rate limit, got %f", got)
+	}
+}
+
+func TestRateLimiterThrottled(t *testing.T) {
+	clock := &testClock{now: time.Unix(0, 0)}
+	limiter := newTestRateLimiter(clock)
+
+	// Send 10 requests per second for two seconds.
+	for i := 0; i < 20; i++ {
+		limiter.Wait()
+		clock.now = clock.now.Add(100 * time.Millisecond)
+	}
+
+	limiter.Throttled()
+
+	if got, expected := limiter.Rate(), 7.0; got < expected-1 || got > expected+1 {
+		t.Fatalf("got rate %f, expected about %f", got, expected)
+	}
+
+	clock.slept = 0
+	for i := 0; i < 14; i++ {
+		limiter.Wait()
+	}
+
+	// 14 requests at 7 requests per second take about 2 seconds.
+	if clock.slept < 1500*time.Millisecond || clock.slept > 2500*time.Millisecond {
+		t.Errorf("got delay %s, expected about 2s", clock.slept)
+	}
+
+	limiter.Throttled()
+	limiter.Throttled()
+	limiter.Throttled()
+	limiter.Throttled()
+	limiter.Throttled()
+	limiter.Throttled()
+	limiter.Throttled()
+	limiter.Throttled()
+	limiter.Throttled()
+
+	if got := limiter.Rate(); got != rateLimiterMinRate {
+		t.Errorf("got rate %f, expected minimum rate %f", got, rateLimiterMinRate)
+	}
+}
+
+func TestRateLimiterSucceeded(t *testing.T) {
+	clock := &testClock{now: time.Unix(0, 0)}
+	limiter := newTestRateLimiter(clock)
+
+	for i := 0; i < 20; i++ {
+		limiter.Wait()
+		clock.now = clock.now.Add(100 * time.Millisecond)
+	}
+
+	limiter.Throttled()
+	rate := limiter.Rate()
+
+	limiter.Succeeded()
+
+	if got := limiter.Rate(); got <= rate {
+		t.Errorf("got rate %f, expected more than %f", got, rate)
+	}
+
+	for i := 0; i < 100; i++ {
+		limiter.Succeeded()
+	}
+
+	if got := limiter.Rate(); got != 0 {
+		t.Errorf("expected rate limit to be disabled, got %f", got)
+	}
+}
+
+func TestRateLimitersGet(t *testing.T) {
+	limiters := NewRateLimiters()
+
+	if limiters.Get("ec2") != limiters.Get("ec2") {
+		t.Error("expected the same rate limiter for a service")
+	}
+
+	if limiters.Get("ec2") == limiters.Get("iam") {
+		t.Error("expected different rate limiters for different services")
+	}
+}
@@ -0,0 +1,112 @@
+package retryer
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// ModeLegacy uses the AWS Go SDK default retryer.
+	ModeLegacy = "legacy"
+
+	// ModeStandard caps the exponential backoff between attempts.
+	ModeStandard = "standard"
+
+	// ModeAdaptive behaves as ModeStandard and additionally limits the
+	// client-side request rate of a service after it throttles requests.
+	ModeAdaptive = "adaptive"
+)
+
+// DefaultStandardMaxBackoff is the default maximum backoff between attempts
+// for ModeStandard and ModeAdaptive.
+const DefaultStandardMaxBackoff = 20 * time.Second
+
+// Modes returns all retry modes.
+func Modes() []string {
+	return []string{
+		ModeLegacy,
+		ModeStandard,
+		ModeAdaptive,
+	}
+}
+
+// Policy configures the retry behavior of a service.
+// Zero values use the defaults of the retry mode.
+type Policy struct {
+	MaxAttempts int
+	MaxBackoff  time.Duration
+}
+
+// Config configures the retry behavior of all services.
+type Config struct {
+	Mode       string
+	MaxRetries int
+
+	// ServicePolicies are keyed by AWS Go SDK service name, e.g. ec2.
+	ServicePolicies map[string]Policy
+
+	limiters *RateLimiters
+}
+
+// NewConfig returns a Config for the retry mode.
+func NewConfig(mode string, maxRetries int, servicePolicies map[string]Policy) *Config {
+	c := &Config{
+		Mode:            mode,
+		MaxRetries:      maxRetries,
+		ServicePolicies: servicePolicies,
+	}
+
+	if mode == ModeAdaptive {
+		c.limiters = NewRateLimiters()
+	}
+
+	return c
+}
+
+// Retryer returns the retryer for a service.
+func (c *Config) Retryer(service string) request.Retryer {
+	retryer := client.DefaultRetryer{
+		NumMaxRetries: c.MaxRetries,
+	}
+
+	if c.Mode == ModeStandard || c.Mode == ModeAdaptive {
+		retryer.MaxRetryDelay = DefaultStandardMaxBackoff
+		retryer.MaxThrottleDelay = DefaultStandardMaxBackoff
+	}
+
+	if policy, ok := c.ServicePolicies[service]; ok {
+		if policy.MaxAttempts > 0 {
+			retryer.NumMaxRetries = policy.MaxAttempts - 1
+		}
+
+		if policy.MaxBackoff > 0 {
+			retryer.MaxRetryDelay = policy.MaxBackoff
+			retryer.MaxThrottleDelay = policy.MaxBackoff
+		}
+	}
+
+	return retryer
+}
+
+// IsDefault returns whether the Config retains the AWS Go SDK default behavior.
+func (c *Config) IsDefault() bool {
+	return (c.Mode == "" || c.Mode == ModeLegacy) && len(c.ServicePolicies) == 0
+}
+
+// AddHandlers adds the handlers configuring request retries to the handlers of
+// a session or client. The handlers replace the retryer of each request and
+// leave the retryable errors customized by other handlers unchanged.
+func (c *Config) AddHandlers(handlers *request.Handlers) {
+	handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RetryerHandler",
+		Fn: func(r *request.Request) {
+			r.Retryer = c.Retryer(r.ClientInfo.ServiceName)
+		},
+	})
+
+	if c.limiters != nil {
+		c.limiters.AddHandlers(handlers)
+	}
+}
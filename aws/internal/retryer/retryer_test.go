@@ -0,0 +1,119 @@
+package retryer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestConfigRetryer(t *testing.T) {
+	servicePolicies := map[string]Policy{
+		"ec2": {
+			MaxAttempts: 10,
+			MaxBackoff:  time.Minute,
+		},
+		"iam": {
+			MaxAttempts: 5,
+		},
+	}
+
+	testCases := []struct {
+		Name     string
+		Config   *Config
+		Service  string
+		Expected client.DefaultRetryer
+	}{
+		{
+			Name:    "legacy",
+			Config:  NewConfig(ModeLegacy, 25, nil),
+			Service: "ec2",
+			Expected: client.DefaultRetryer{
+				NumMaxRetries: 25,
+			},
+		},
+		{
+			Name:    "legacy service policy",
+			Config:  NewConfig(ModeLegacy, 25, servicePolicies),
+			Service: "iam",
+			Expected: client.DefaultRetryer{
+				NumMaxRetries: 4,
+			},
+		},
+		{
+			Name:    "standard",
+			Config:  NewConfig(ModeStandard, 25, servicePolicies),
+			Service: "s3",
+			Expected: client.DefaultRetryer{
+				NumMaxRetries:    25,
+				MaxRetryDelay:    DefaultStandardMaxBackoff,
+				MaxThrottleDelay: DefaultStandardMaxBackoff,
+			},
+		},
+		{
+			Name:    "standard service policy",
+			Config:  NewConfig(ModeStandard, 25, servicePolicies),
+			Service: "ec2",
+			Expected: client.DefaultRetryer{
+				NumMaxRetries:    9,
+				MaxRetryDelay:    time.Minute,
+				MaxThrottleDelay: time.Minute,
+			},
+		},
+		{
+			Name:    "adaptive service policy",
+			Config:  NewConfig(ModeAdaptive, 25, servicePolicies),
+			Service: "iam",
+			Expected: client.DefaultRetryer{
+				NumMaxRetries:    4,
+				MaxRetryDelay:    DefaultStandardMaxBackoff,
+				MaxThrottleDelay: DefaultStandardMaxBackoff,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.Config.Retryer(testCase.Service)
+
+			if got != testCase.Expected {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestConfigAddHandlers(t *testing.T) {
+	config := NewConfig(ModeStandard, 25, map[string]Policy{
+		"ec2": {
+			MaxAttempts: 3,
+		},
+	})
+
+	handlers := request.Handlers{}
+	config.AddHandlers(&handlers)
+
+	r := request.New(aws.Config{}, metadata.ClientInfo{ServiceName: "ec2"}, handlers, client.DefaultRetryer{NumMaxRetries: 25}, &request.Operation{Name: "DescribeInstances"}, nil, nil)
+	r.Handlers.Validate.Run(r)
+
+	if got, expected := r.MaxRetries(), 2; got != expected {
+		t.Errorf("got max retries %d, expected %d", got, expected)
+	}
+}
+
+func TestConfigIsDefault(t *testing.T) {
+	if !NewConfig(ModeLegacy, 25, nil).IsDefault() {
+		t.Error("expected legacy mode to be default")
+	}
+
+	if NewConfig(ModeLegacy, 25, map[string]Policy{"ec2": {MaxAttempts: 3}}).IsDefault() {
+		t.Error("expected legacy mode with service policies not to be default")
+	}
+
+	if NewConfig(ModeAdaptive, 25, nil).IsDefault() {
+		t.Error("expected adaptive mode not to be default")
+	}
+}
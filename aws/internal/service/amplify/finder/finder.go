@@ -0,0 +1,109 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+)
+
+// AppByID returns the app corresponding to the specified ID.
+// Returns nil if no app is found.
+func AppByID(conn *amplify.Amplify, id string) (*amplify.App, error) {
+	input := &amplify.GetAppInput{
+		AppId: aws.String(id),
+	}
+
+	output, err := conn.GetApp(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.App, nil
+}
+
+// BackendEnvironmentByAppIDAndEnvironmentName returns the backend environment corresponding to the specified app ID and environment name.
+// Returns nil if no backend environment is found.
+func BackendEnvironmentByAppIDAndEnvironmentName(conn *amplify.Amplify, appID, environmentName string) (*amplify.BackendEnvironment, error) {
+	input := &amplify.GetBackendEnvironmentInput{
+		AppId:           aws.String(appID),
+		EnvironmentName: aws.String(environmentName),
+	}
+
+	output, err := conn.GetBackendEnvironment(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.BackendEnvironment, nil
+}
+
+// BranchByAppIDAndBranchName returns the branch corresponding to the specified app ID and branch name.
+// Returns nil if no branch is found.
+func BranchByAppIDAndBranchName(conn *amplify.Amplify, appID, branchName string) (*amplify.Branch, error) {
+	input := &amplify.GetBranchInput{
+		AppId:      aws.String(appID),
+		BranchName: aws.String(branchName),
+	}
+
+	output, err := conn.GetBranch(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Branch, nil
+}
+
+// DomainAssociationByAppIDAndDomainName returns the domain association corresponding to the specified app ID and domain name.
+// Returns nil if no domain association is found.
+func DomainAssociationByAppIDAndDomainName(conn *amplify.Amplify, appID, domainName string) (*amplify.DomainAssociation, error) {
+	input := &amplify.GetDomainAssociationInput{
+		AppId:      aws.String(appID),
+		DomainName: aws.String(domainName),
+	}
+
+	output, err := conn.GetDomainAssociation(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.DomainAssociation, nil
+}
+
+// WebhookByID returns the webhook corresponding to the specified ID.
+// Returns nil if no webhook is found.
+func WebhookByID(conn *amplify.Amplify, id string) (*amplify.Webhook, error) {
+	input := &amplify.GetWebhookInput{
+		WebhookId: aws.String(id),
+	}
+
+	output, err := conn.GetWebhook(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Webhook, nil
+}
@@ -0,0 +1,33 @@
+package waiter
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/amplify/finder"
+)
+
+// DomainAssociationStatus fetches the Domain Association and its Status
+func DomainAssociationStatus(conn *amplify.Amplify, appID, domainName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		domainAssociation, err := finder.DomainAssociationByAppIDAndDomainName(conn, appID, domainName)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if domainAssociation == nil {
+			return nil, "", nil
+		}
+
+		// Error messages can also be contained in the response with FAILED status
+
+		if aws.StringValue(domainAssociation.DomainStatus) == amplify.DomainStatusFailed {
+			return domainAssociation, amplify.DomainStatusFailed, fmt.Errorf("%s", aws.StringValue(domainAssociation.StatusReason))
+		}
+
+		return domainAssociation, aws.StringValue(domainAssociation.DomainStatus), nil
+	}
+}
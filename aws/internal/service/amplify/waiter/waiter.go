@@ -0,0 +1,67 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Domain Association to be created
+	DomainAssociationCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Domain Association's certificate to be verified
+	DomainAssociationVerifiedTimeout = 15 * time.Minute
+)
+
+// DomainAssociationCreated waits for a Domain Association to return Pending Verification or later
+func DomainAssociationCreated(conn *amplify.Amplify, appID, domainName string) (*amplify.DomainAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			amplify.DomainStatusCreating,
+			amplify.DomainStatusInProgress,
+			amplify.DomainStatusRequestingCertificate,
+		},
+		Target: []string{
+			amplify.DomainStatusPendingVerification,
+			amplify.DomainStatusPendingDeployment,
+			amplify.DomainStatusAvailable,
+		},
+		Refresh: DomainAssociationStatus(conn, appID, domainName),
+		Timeout: DomainAssociationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*amplify.DomainAssociation); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// DomainAssociationVerified waits for a Domain Association's certificate to be verified
+func DomainAssociationVerified(conn *amplify.Amplify, appID, domainName string) (*amplify.DomainAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			amplify.DomainStatusUpdating,
+			amplify.DomainStatusInProgress,
+			amplify.DomainStatusPendingVerification,
+		},
+		Target: []string{
+			amplify.DomainStatusPendingDeployment,
+			amplify.DomainStatusAvailable,
+		},
+		Refresh: DomainAssociationStatus(conn, appID, domainName),
+		Timeout: DomainAssociationVerifiedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*amplify.DomainAssociation); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,42 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+)
+
+// ConnectionByArn returns the CodeStar Connections connection corresponding to the specified ARN.
+// Returns nil if no connection is found.
+func ConnectionByArn(conn *codestarconnections.CodeStarConnections, arn string) (*codestarconnections.Connection, error) {
+	input := &codestarconnections.GetConnectionInput{
+		ConnectionArn: aws.String(arn),
+	}
+
+	output, err := conn.GetConnection(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Connection, nil
+}
+
+// HostByArn returns the CodeStar Connections host corresponding to the specified ARN.
+// Returns nil if no host is found.
+func HostByArn(conn *codestarconnections.CodeStarConnections, arn string) (*codestarconnections.GetHostOutput, error) {
+	input := &codestarconnections.GetHostInput{
+		HostArn: aws.String(arn),
+	}
+
+	output, err := conn.GetHost(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,38 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/codestarconnections/finder"
+)
+
+const (
+	HostStatusAvailable                     = "AVAILABLE"
+	HostStatusPending                       = "PENDING"
+	HostStatusVpcConfigDeleting             = "VPC_CONFIG_DELETING"
+	HostStatusVpcConfigFailedInitialization = "VPC_CONFIG_FAILED_INITIALIZATION"
+	HostStatusVpcConfigInitializing         = "VPC_CONFIG_INITIALIZING"
+)
+
+// HostStatus fetches the Host and its Status
+func HostStatus(conn *codestarconnections.CodeStarConnections, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.HostByArn(conn, arn)
+
+		if tfawserr.ErrCodeEquals(err, codestarconnections.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
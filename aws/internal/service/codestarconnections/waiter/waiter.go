@@ -0,0 +1,52 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Host to return Pending or Available
+	HostCreatedTimeout = 30 * time.Minute
+
+	// Maximum amount of time to wait for a Host to be deleted
+	HostDeletedTimeout = 30 * time.Minute
+)
+
+// HostPendingOrAvailable waits for a Host to return Pending or Available
+func HostPendingOrAvailable(conn *codestarconnections.CodeStarConnections, arn string) (*codestarconnections.GetHostOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{HostStatusVpcConfigInitializing},
+		Target:  []string{HostStatusAvailable, HostStatusPending},
+		Refresh: HostStatus(conn, arn),
+		Timeout: HostCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*codestarconnections.GetHostOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// HostDeleted waits for a Host to be deleted
+func HostDeleted(conn *codestarconnections.CodeStarConnections, arn string) (*codestarconnections.GetHostOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{HostStatusVpcConfigDeleting},
+		Target:  []string{},
+		Refresh: HostStatus(conn, arn),
+		Timeout: HostDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*codestarconnections.GetHostOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
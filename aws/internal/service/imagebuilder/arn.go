@@ -0,0 +1,154 @@
+package imagebuilder
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+const (
+	// SemanticVersionWildcard is the version segment Image Builder accepts in place
+	// of a major, minor or patch number to select the latest matching version.
+	SemanticVersionWildcard = "x"
+)
+
+// VersionedArn is an Image Builder resource ARN whose resource is of the form
+// TYPE/NAME/VERSION[/BUILD], e.g. component/example/1.0.0/1.
+type VersionedArn struct {
+	arn.ARN
+
+	ResourceType string
+	Name         string
+	Version      string
+	BuildVersion string
+}
+
+// ParseVersionedArn parses an Image Builder resource ARN, accepting both x.x.x
+// version wildcards and build versions.
+func ParseVersionedArn(s string) (*VersionedArn, error) {
+	parsedArn, err := arn.Parse(s)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing ARN (%s): %w", s, err)
+	}
+
+	parts := strings.Split(parsedArn.Resource, "/")
+
+	if len(parts) != 3 && len(parts) != 4 {
+		return nil, fmt.Errorf("unexpected format for ARN resource (%s), expected TYPE/NAME/VERSION[/BUILD]", parsedArn.Resource)
+	}
+
+	v := &VersionedArn{
+		ARN:          parsedArn,
+		ResourceType: parts[0],
+		Name:         parts[1],
+		Version:      parts[2],
+	}
+
+	if len(parts) == 4 {
+		v.BuildVersion = parts[3]
+	}
+
+	if _, err := ParseSemanticVersion(v.Version); err != nil {
+		return nil, fmt.Errorf("error parsing ARN (%s): %w", s, err)
+	}
+
+	if v.BuildVersion != "" {
+		if v.HasVersionWildcard() {
+			return nil, fmt.Errorf("error parsing ARN (%s): build version cannot be combined with a version wildcard", s)
+		}
+
+		if _, err := strconv.Atoi(v.BuildVersion); err != nil {
+			return nil, fmt.Errorf("error parsing ARN (%s): invalid build version (%s)", s, v.BuildVersion)
+		}
+	}
+
+	return v, nil
+}
+
+// HasVersionWildcard returns whether any part of the semantic version is a wildcard.
+func (v *VersionedArn) HasVersionWildcard() bool {
+	version, err := ParseSemanticVersion(v.Version)
+
+	if err != nil {
+		return false
+	}
+
+	return version.HasWildcard()
+}
+
+// VersionlessArn returns the ARN without its version and build version,
+// e.g. arn:aws:imagebuilder:us-east-1:123456789012:component/example.
+func (v *VersionedArn) VersionlessArn() string {
+	a := v.ARN
+	a.Resource = strings.Join([]string{v.ResourceType, v.Name}, "/")
+
+	return a.String()
+}
+
+// SemanticVersion is a major.minor.patch version, where each part may be a wildcard.
+type SemanticVersion [3]string
+
+// ParseSemanticVersion parses a version of the form MAJOR.MINOR.PATCH where
+// each part is a non-negative integer or the "x" wildcard.
+func ParseSemanticVersion(s string) (SemanticVersion, error) {
+	var version SemanticVersion
+
+	parts := strings.Split(s, ".")
+
+	if len(parts) != 3 {
+		return version, fmt.Errorf("invalid semantic version (%s), expected MAJOR.MINOR.PATCH", s)
+	}
+
+	for i, part := range parts {
+		if part != SemanticVersionWildcard {
+			if n, err := strconv.Atoi(part); err != nil || n < 0 {
+				return version, fmt.Errorf("invalid semantic version (%s), expected MAJOR.MINOR.PATCH", s)
+			}
+		}
+
+		version[i] = part
+	}
+
+	return version, nil
+}
+
+// HasWildcard returns whether any part of the version is a wildcard.
+func (v SemanticVersion) HasWildcard() bool {
+	for _, part := range v {
+		if part == SemanticVersionWildcard {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Matches returns whether the version, which must not contain wildcards,
+// is selected by the pattern.
+func (v SemanticVersion) Matches(pattern SemanticVersion) bool {
+	for i := range v {
+		if pattern[i] != SemanticVersionWildcard && pattern[i] != v[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Less returns whether the version, which must not contain wildcards, sorts
+// before the other version.
+func (v SemanticVersion) Less(other SemanticVersion) bool {
+	for i := range v {
+		a, _ := strconv.Atoi(v[i])
+		b, _ := strconv.Atoi(other[i])
+
+		if a != b {
+			return a < b
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,184 @@
+package imagebuilder
+
+import (
+	"testing"
+)
+
+func TestParseVersionedArn(t *testing.T) {
+	testCases := []struct {
+		TestName                string
+		InputArn                string
+		ExpectedError           bool
+		ExpectedName            string
+		ExpectedVersion         string
+		ExpectedBuildVersion    string
+		ExpectedVersionWildcard bool
+		ExpectedVersionlessArn  string
+	}{
+		{
+			TestName:      "empty ARN",
+			InputArn:      "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing version",
+			InputArn:      "arn:aws:imagebuilder:us-east-1:123456789012:component/example",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "invalid version",
+			InputArn:      "arn:aws:imagebuilder:us-east-1:123456789012:component/example/1.0",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "invalid build version",
+			InputArn:      "arn:aws:imagebuilder:us-east-1:123456789012:component/example/1.0.0/a",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "wildcard with build version",
+			InputArn:      "arn:aws:imagebuilder:us-east-1:123456789012:component/example/x.x.x/1",
+			ExpectedError: true,
+		},
+		{
+			TestName:               "version",
+			InputArn:               "arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/example/1.0.0",
+			ExpectedName:           "example",
+			ExpectedVersion:        "1.0.0",
+			ExpectedVersionlessArn: "arn:aws:imagebuilder:us-east-1:123456789012:image-recipe/example",
+		},
+		{
+			TestName:               "build version",
+			InputArn:               "arn:aws:imagebuilder:us-east-1:123456789012:component/example/1.0.0/2",
+			ExpectedName:           "example",
+			ExpectedVersion:        "1.0.0",
+			ExpectedBuildVersion:   "2",
+			ExpectedVersionlessArn: "arn:aws:imagebuilder:us-east-1:123456789012:component/example",
+		},
+		{
+			TestName:                "version wildcard",
+			InputArn:                "arn:aws:imagebuilder:us-east-1:aws:image/amazon-linux-2-x86/x.x.x",
+			ExpectedName:            "amazon-linux-2-x86",
+			ExpectedVersion:         "x.x.x",
+			ExpectedVersionWildcard: true,
+			ExpectedVersionlessArn:  "arn:aws:imagebuilder:us-east-1:aws:image/amazon-linux-2-x86",
+		},
+		{
+			TestName:                "partial version wildcard",
+			InputArn:                "arn:aws:imagebuilder:us-east-1:aws:component/update-linux/1.x.x",
+			ExpectedName:            "update-linux",
+			ExpectedVersion:         "1.x.x",
+			ExpectedVersionWildcard: true,
+			ExpectedVersionlessArn:  "arn:aws:imagebuilder:us-east-1:aws:component/update-linux",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := ParseVersionedArn(testCase.InputArn)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.Name != testCase.ExpectedName {
+				t.Errorf("got name %s, expected %s", got.Name, testCase.ExpectedName)
+			}
+
+			if got.Version != testCase.ExpectedVersion {
+				t.Errorf("got version %s, expected %s", got.Version, testCase.ExpectedVersion)
+			}
+
+			if got.BuildVersion != testCase.ExpectedBuildVersion {
+				t.Errorf("got build version %s, expected %s", got.BuildVersion, testCase.ExpectedBuildVersion)
+			}
+
+			if got.HasVersionWildcard() != testCase.ExpectedVersionWildcard {
+				t.Errorf("got version wildcard %t, expected %t", got.HasVersionWildcard(), testCase.ExpectedVersionWildcard)
+			}
+
+			if got.VersionlessArn() != testCase.ExpectedVersionlessArn {
+				t.Errorf("got versionless ARN %s, expected %s", got.VersionlessArn(), testCase.ExpectedVersionlessArn)
+			}
+		})
+	}
+}
+
+func TestSemanticVersionMatchesAndLess(t *testing.T) {
+	testCases := []struct {
+		TestName        string
+		Version         string
+		Other           string
+		ExpectedMatches bool
+		ExpectedLess    bool
+	}{
+		{
+			TestName:        "equal",
+			Version:         "1.0.0",
+			Other:           "1.0.0",
+			ExpectedMatches: true,
+		},
+		{
+			TestName:        "wildcard",
+			Version:         "2.3.4",
+			Other:           "x.x.x",
+			ExpectedMatches: true,
+		},
+		{
+			TestName:        "partial wildcard",
+			Version:         "1.2.3",
+			Other:           "1.x.x",
+			ExpectedMatches: true,
+		},
+		{
+			TestName: "partial wildcard mismatch",
+			Version:  "2.2.3",
+			Other:    "1.x.x",
+		},
+		{
+			TestName:     "numeric comparison",
+			Version:      "1.9.0",
+			Other:        "1.10.0",
+			ExpectedLess: true,
+		},
+		{
+			TestName: "greater",
+			Version:  "2.0.0",
+			Other:    "1.10.10",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			version, err := ParseSemanticVersion(testCase.Version)
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			other, err := ParseSemanticVersion(testCase.Other)
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if got := version.Matches(other); got != testCase.ExpectedMatches {
+				t.Errorf("got matches %t, expected %t", got, testCase.ExpectedMatches)
+			}
+
+			if !other.HasWildcard() {
+				if got := version.Less(other); got != testCase.ExpectedLess {
+					t.Errorf("got less %t, expected %t", got, testCase.ExpectedLess)
+				}
+			}
+		})
+	}
+}
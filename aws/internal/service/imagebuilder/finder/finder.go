@@ -0,0 +1,403 @@
+package finder
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	tfimagebuilder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/imagebuilder"
+)
+
+// ComponentByArn returns the Component corresponding to the specified ARN.
+// An ARN with an x.x.x version wildcard or without a build version resolves
+// to the latest matching build version.
+func ComponentByArn(conn *imagebuilder.Imagebuilder, componentArn string) (*imagebuilder.Component, error) {
+	componentBuildVersionArn, err := ComponentBuildVersionArn(conn, componentArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &imagebuilder.GetComponentInput{
+		ComponentBuildVersionArn: aws.String(componentBuildVersionArn),
+	}
+
+	output, err := conn.GetComponent(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Component, nil
+}
+
+// ComponentBuildVersionArn resolves the specified Component ARN to a build version ARN.
+func ComponentBuildVersionArn(conn *imagebuilder.Imagebuilder, componentArn string) (string, error) {
+	versionedArn, err := tfimagebuilder.ParseVersionedArn(componentArn)
+
+	if err != nil {
+		return "", err
+	}
+
+	if versionedArn.BuildVersion != "" {
+		return componentArn, nil
+	}
+
+	componentVersionArn := componentArn
+
+	if versionedArn.HasVersionWildcard() {
+		input := &imagebuilder.ListComponentsInput{
+			Owner: aws.String(imagebuilderOwner(versionedArn)),
+			Filters: []*imagebuilder.Filter{
+				{
+					Name:   aws.String("name"),
+					Values: aws.StringSlice([]string{versionedArn.Name}),
+				},
+			},
+		}
+
+		var versions []latestCandidate
+
+		err := conn.ListComponentsPages(input, func(page *imagebuilder.ListComponentsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, componentVersion := range page.ComponentVersionList {
+				if componentVersion == nil || aws.StringValue(componentVersion.Name) != versionedArn.Name {
+					continue
+				}
+
+				versions = append(versions, latestCandidate{
+					Arn:     aws.StringValue(componentVersion.Arn),
+					Version: aws.StringValue(componentVersion.Version),
+				})
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		componentVersionArn, err = latestSemanticVersionArn(versionedArn.Version, versions)
+
+		if err != nil {
+			return "", fmt.Errorf("error resolving Image Builder Component (%s): %w", componentArn, err)
+		}
+	}
+
+	input := &imagebuilder.ListComponentBuildVersionsInput{
+		ComponentVersionArn: aws.String(componentVersionArn),
+	}
+
+	var builds []latestCandidate
+
+	err = conn.ListComponentBuildVersionsPages(input, func(page *imagebuilder.ListComponentBuildVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, componentSummary := range page.ComponentSummaryList {
+			if componentSummary == nil {
+				continue
+			}
+
+			builds = append(builds, latestCandidate{
+				Arn: aws.StringValue(componentSummary.Arn),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	componentBuildVersionArn, err := latestBuildVersionArn(builds)
+
+	if err != nil {
+		return "", fmt.Errorf("error resolving Image Builder Component (%s): %w", componentArn, err)
+	}
+
+	return componentBuildVersionArn, nil
+}
+
+// DistributionConfigurationByArn returns the Distribution Configuration corresponding to the specified ARN.
+func DistributionConfigurationByArn(conn *imagebuilder.Imagebuilder, distributionConfigurationArn string) (*imagebuilder.DistributionConfiguration, error) {
+	input := &imagebuilder.GetDistributionConfigurationInput{
+		DistributionConfigurationArn: aws.String(distributionConfigurationArn),
+	}
+
+	output, err := conn.GetDistributionConfiguration(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.DistributionConfiguration, nil
+}
+
+// ImageByArn returns the Image corresponding to the specified ARN.
+// An ARN with an x.x.x version wildcard or without a build version resolves
+// to the latest matching build version.
+func ImageByArn(conn *imagebuilder.Imagebuilder, imageArn string) (*imagebuilder.Image, error) {
+	imageBuildVersionArn, err := ImageBuildVersionArn(conn, imageArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &imagebuilder.GetImageInput{
+		ImageBuildVersionArn: aws.String(imageBuildVersionArn),
+	}
+
+	output, err := conn.GetImage(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Image, nil
+}
+
+// ImageBuildVersionArn resolves the specified Image ARN to a build version ARN.
+func ImageBuildVersionArn(conn *imagebuilder.Imagebuilder, imageArn string) (string, error) {
+	versionedArn, err := tfimagebuilder.ParseVersionedArn(imageArn)
+
+	if err != nil {
+		return "", err
+	}
+
+	if versionedArn.BuildVersion != "" {
+		return imageArn, nil
+	}
+
+	imageVersionArn := imageArn
+
+	if versionedArn.HasVersionWildcard() {
+		input := &imagebuilder.ListImagesInput{
+			Owner: aws.String(imagebuilderOwner(versionedArn)),
+			Filters: []*imagebuilder.Filter{
+				{
+					Name:   aws.String("name"),
+					Values: aws.StringSlice([]string{versionedArn.Name}),
+				},
+			},
+		}
+
+		var versions []latestCandidate
+
+		err := conn.ListImagesPages(input, func(page *imagebuilder.ListImagesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, imageVersion := range page.ImageVersionList {
+				if imageVersion == nil || aws.StringValue(imageVersion.Name) != versionedArn.Name {
+					continue
+				}
+
+				versions = append(versions, latestCandidate{
+					Arn:     aws.StringValue(imageVersion.Arn),
+					Version: aws.StringValue(imageVersion.Version),
+				})
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		imageVersionArn, err = latestSemanticVersionArn(versionedArn.Version, versions)
+
+		if err != nil {
+			return "", fmt.Errorf("error resolving Image Builder Image (%s): %w", imageArn, err)
+		}
+	}
+
+	input := &imagebuilder.ListImageBuildVersionsInput{
+		ImageVersionArn: aws.String(imageVersionArn),
+	}
+
+	var builds []latestCandidate
+
+	err = conn.ListImageBuildVersionsPages(input, func(page *imagebuilder.ListImageBuildVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, imageSummary := range page.ImageSummaryList {
+			if imageSummary == nil {
+				continue
+			}
+
+			builds = append(builds, latestCandidate{
+				Arn: aws.StringValue(imageSummary.Arn),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	imageBuildVersionArn, err := latestBuildVersionArn(builds)
+
+	if err != nil {
+		return "", fmt.Errorf("error resolving Image Builder Image (%s): %w", imageArn, err)
+	}
+
+	return imageBuildVersionArn, nil
+}
+
+// ImagePipelineByArn returns the Image Pipeline corresponding to the specified ARN.
+func ImagePipelineByArn(conn *imagebuilder.Imagebuilder, imagePipelineArn string) (*imagebuilder.ImagePipeline, error) {
+	input := &imagebuilder.GetImagePipelineInput{
+		ImagePipelineArn: aws.String(imagePipelineArn),
+	}
+
+	output, err := conn.GetImagePipeline(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.ImagePipeline, nil
+}
+
+// ImageRecipeByArn returns the Image Recipe corresponding to the specified ARN.
+func ImageRecipeByArn(conn *imagebuilder.Imagebuilder, imageRecipeArn string) (*imagebuilder.ImageRecipe, error) {
+	input := &imagebuilder.GetImageRecipeInput{
+		ImageRecipeArn: aws.String(imageRecipeArn),
+	}
+
+	output, err := conn.GetImageRecipe(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.ImageRecipe, nil
+}
+
+// InfrastructureConfigurationByArn returns the Infrastructure Configuration corresponding to the specified ARN.
+func InfrastructureConfigurationByArn(conn *imagebuilder.Imagebuilder, infrastructureConfigurationArn string) (*imagebuilder.InfrastructureConfiguration, error) {
+	input := &imagebuilder.GetInfrastructureConfigurationInput{
+		InfrastructureConfigurationArn: aws.String(infrastructureConfigurationArn),
+	}
+
+	output, err := conn.GetInfrastructureConfiguration(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.InfrastructureConfiguration, nil
+}
+
+type latestCandidate struct {
+	Arn     string
+	Version string
+}
+
+// imagebuilderOwner returns the ListComponents/ListImages owner for the ARN
+// account, which is "aws" for Amazon managed resources.
+func imagebuilderOwner(versionedArn *tfimagebuilder.VersionedArn) string {
+	if versionedArn.AccountID == "aws" {
+		return imagebuilder.OwnershipAmazon
+	}
+
+	return imagebuilder.OwnershipSelf
+}
+
+func latestSemanticVersionArn(pattern string, candidates []latestCandidate) (string, error) {
+	wanted, err := tfimagebuilder.ParseSemanticVersion(pattern)
+
+	if err != nil {
+		return "", err
+	}
+
+	var latestArn string
+	var latest tfimagebuilder.SemanticVersion
+
+	for _, candidate := range candidates {
+		version, err := tfimagebuilder.ParseSemanticVersion(candidate.Version)
+
+		if err != nil || version.HasWildcard() || !version.Matches(wanted) {
+			continue
+		}
+
+		if latestArn == "" || latest.Less(version) {
+			latestArn = candidate.Arn
+			latest = version
+		}
+	}
+
+	if latestArn == "" {
+		return "", fmt.Errorf("no version matching %s found", pattern)
+	}
+
+	return latestArn, nil
+}
+
+func latestBuildVersionArn(candidates []latestCandidate) (string, error) {
+	var latestArn string
+	latest := -1
+
+	for _, candidate := range candidates {
+		versionedArn, err := tfimagebuilder.ParseVersionedArn(candidate.Arn)
+
+		if err != nil || versionedArn.BuildVersion == "" {
+			continue
+		}
+
+		build, err := strconv.Atoi(versionedArn.BuildVersion)
+
+		if err != nil {
+			continue
+		}
+
+		if build > latest {
+			latestArn = candidate.Arn
+			latest = build
+		}
+	}
+
+	if latestArn == "" {
+		return "", fmt.Errorf("no build version found")
+	}
+
+	return latestArn, nil
+}
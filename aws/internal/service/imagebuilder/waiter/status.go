@@ -0,0 +1,40 @@
+package waiter
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/imagebuilder/finder"
+)
+
+// ImageStatus fetches the Image and its Status
+func ImageStatus(conn *imagebuilder.Imagebuilder, imageBuildVersionArn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		image, err := finder.ImageByArn(conn, imageBuildVersionArn)
+
+		if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if image == nil || image.State == nil {
+			return nil, "", nil
+		}
+
+		status := aws.StringValue(image.State.Status)
+
+		// Error messages can also be contained in the response with FAILED status
+
+		if status == imagebuilder.ImageStatusFailed {
+			return image, status, fmt.Errorf("%s", aws.StringValue(image.State.Reason))
+		}
+
+		return image, status, nil
+	}
+}
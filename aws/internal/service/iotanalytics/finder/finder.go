@@ -0,0 +1,86 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+)
+
+// ChannelByName returns the IoT Analytics channel corresponding to the specified name.
+// Returns nil if no channel is found.
+func ChannelByName(conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Channel, error) {
+	input := &iotanalytics.DescribeChannelInput{
+		ChannelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeChannel(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Channel, nil
+}
+
+// DatasetByName returns the IoT Analytics dataset corresponding to the specified name.
+// Returns nil if no dataset is found.
+func DatasetByName(conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Dataset, error) {
+	input := &iotanalytics.DescribeDatasetInput{
+		DatasetName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDataset(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Dataset, nil
+}
+
+// DatastoreByName returns the IoT Analytics datastore corresponding to the specified name.
+// Returns nil if no datastore is found.
+func DatastoreByName(conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Datastore, error) {
+	input := &iotanalytics.DescribeDatastoreInput{
+		DatastoreName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDatastore(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Datastore, nil
+}
+
+// PipelineByName returns the IoT Analytics pipeline corresponding to the specified name.
+// Returns nil if no pipeline is found.
+func PipelineByName(conn *iotanalytics.IoTAnalytics, name string) (*iotanalytics.Pipeline, error) {
+	input := &iotanalytics.DescribePipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	output, err := conn.DescribePipeline(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Pipeline, nil
+}
@@ -0,0 +1,46 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+)
+
+// DetectorModelByName returns the latest version of the IoT Events detector model corresponding to the specified name.
+// Returns nil if no detector model is found.
+func DetectorModelByName(conn *iotevents.IoTEvents, name string) (*iotevents.DetectorModel, error) {
+	input := &iotevents.DescribeDetectorModelInput{
+		DetectorModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDetectorModel(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DetectorModel == nil || output.DetectorModel.DetectorModelConfiguration == nil {
+		return nil, nil
+	}
+
+	return output.DetectorModel, nil
+}
+
+// InputByName returns the IoT Events input corresponding to the specified name.
+// Returns nil if no input is found.
+func InputByName(conn *iotevents.IoTEvents, name string) (*iotevents.Input, error) {
+	input := &iotevents.DescribeInputInput{
+		InputName: aws.String(name),
+	}
+
+	output, err := conn.DescribeInput(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Input == nil || output.Input.InputConfiguration == nil {
+		return nil, nil
+	}
+
+	return output.Input, nil
+}
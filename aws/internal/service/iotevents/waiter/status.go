@@ -0,0 +1,51 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+)
+
+// DetectorModelStatus fetches the DetectorModel and its Status
+func DetectorModelStatus(conn *iotevents.IoTEvents, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.DetectorModelByName(conn, name)
+
+		if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.DetectorModelConfiguration.Status), nil
+	}
+}
+
+// InputStatus fetches the Input and its Status
+func InputStatus(conn *iotevents.IoTEvents, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.InputByName(conn, name)
+
+		if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.InputConfiguration.Status), nil
+	}
+}
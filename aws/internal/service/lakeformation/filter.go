@@ -0,0 +1,138 @@
+package lakeformation
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+)
+
+const (
+	// TableNameAllTables is the table name Lake Formation reports for
+	// permissions granted using a table wildcard.
+	TableNameAllTables = "ALL_TABLES"
+
+	TableTypeTable            = "Table"
+	TableTypeTableWithColumns = "TableWithColumns"
+)
+
+// FilterPermissions removes permissions returned by ListPermissions that do not
+// pertain to the requested resource.
+//
+// For most resources the ListPermissions input describes exactly what should be
+// returned. Tables are the exception: ListPermissions does not accept a table with
+// columns resource, so listing by table returns permissions granted on the table
+// as well as on any of its columns. Additionally, SELECT granted on a whole table
+// is reported as a table with columns resource using a column wildcard.
+func FilterPermissions(input *lakeformation.ListPermissionsInput, tableType string, columnNames []*string, excludedColumnNames []*string, columnWildcard bool, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	if input == nil || input.Resource == nil {
+		return nil
+	}
+
+	switch {
+	case input.Resource.Catalog != nil:
+		return filterPermissions(allPermissions, func(r *lakeformation.Resource) bool {
+			return r.Catalog != nil
+		})
+	case input.Resource.DataLocation != nil:
+		return filterPermissions(allPermissions, func(r *lakeformation.Resource) bool {
+			return r.DataLocation != nil
+		})
+	case input.Resource.Database != nil:
+		return filterPermissions(allPermissions, func(r *lakeformation.Resource) bool {
+			return r.Database != nil
+		})
+	case input.Resource.Table != nil && tableType == TableTypeTableWithColumns:
+		return filterTableWithColumnsPermissions(input.Resource.Table, columnNames, excludedColumnNames, columnWildcard, allPermissions)
+	case input.Resource.Table != nil:
+		return filterTablePermissions(input.Resource.Table, allPermissions)
+	}
+
+	return nil
+}
+
+func filterPermissions(allPermissions []*lakeformation.PrincipalResourcePermissions, match func(*lakeformation.Resource) bool) []*lakeformation.PrincipalResourcePermissions {
+	var result []*lakeformation.PrincipalResourcePermissions
+
+	for _, permission := range allPermissions {
+		if permission == nil || permission.Resource == nil {
+			continue
+		}
+
+		if match(permission.Resource) {
+			result = append(result, permission)
+		}
+	}
+
+	return result
+}
+
+func filterTablePermissions(table *lakeformation.TableResource, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	name := aws.StringValue(table.Name)
+
+	if table.TableWildcard != nil {
+		name = TableNameAllTables
+	}
+
+	return filterPermissions(allPermissions, func(r *lakeformation.Resource) bool {
+		if r.Table != nil {
+			return aws.StringValue(r.Table.DatabaseName) == aws.StringValue(table.DatabaseName) &&
+				(aws.StringValue(r.Table.Name) == name || (table.TableWildcard != nil && r.Table.TableWildcard != nil))
+		}
+
+		// SELECT on a whole table is reported as a column wildcard without exclusions.
+		if r.TableWithColumns != nil {
+			return aws.StringValue(r.TableWithColumns.DatabaseName) == aws.StringValue(table.DatabaseName) &&
+				aws.StringValue(r.TableWithColumns.Name) == name &&
+				r.TableWithColumns.ColumnWildcard != nil &&
+				len(r.TableWithColumns.ColumnWildcard.ExcludedColumnNames) == 0
+		}
+
+		return false
+	})
+}
+
+func filterTableWithColumnsPermissions(table *lakeformation.TableResource, columnNames []*string, excludedColumnNames []*string, columnWildcard bool, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	return filterPermissions(allPermissions, func(r *lakeformation.Resource) bool {
+		if r.TableWithColumns == nil {
+			return false
+		}
+
+		if aws.StringValue(r.TableWithColumns.DatabaseName) != aws.StringValue(table.DatabaseName) ||
+			aws.StringValue(r.TableWithColumns.Name) != aws.StringValue(table.Name) {
+			return false
+		}
+
+		if columnWildcard {
+			return r.TableWithColumns.ColumnWildcard != nil &&
+				StringSetsEqual(r.TableWithColumns.ColumnWildcard.ExcludedColumnNames, excludedColumnNames)
+		}
+
+		return r.TableWithColumns.ColumnWildcard == nil &&
+			StringSetsEqual(r.TableWithColumns.ColumnNames, columnNames)
+	})
+}
+
+// StringSetsEqual returns whether both slices contain the same strings,
+// irrespective of order.
+func StringSetsEqual(a, b []*string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	m := make(map[string]int, len(a))
+
+	for _, s := range a {
+		m[aws.StringValue(s)]++
+	}
+
+	for _, s := range b {
+		v := aws.StringValue(s)
+
+		if m[v] == 0 {
+			return false
+		}
+
+		m[v]--
+	}
+
+	return true
+}
@@ -0,0 +1,152 @@
+package lakeformation
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+)
+
+func TestFilterPermissions(t *testing.T) {
+	principal := &lakeformation.DataLakePrincipal{
+		DataLakePrincipalIdentifier: aws.String("arn:aws:iam::123456789012:role/example"),
+	}
+
+	tablePermission := &lakeformation.PrincipalResourcePermissions{
+		Permissions: aws.StringSlice([]string{lakeformation.PermissionAlter}),
+		Principal:   principal,
+		Resource: &lakeformation.Resource{
+			Table: &lakeformation.TableResource{
+				DatabaseName: aws.String("db"),
+				Name:         aws.String("tbl"),
+			},
+		},
+	}
+	tableSelectPermission := &lakeformation.PrincipalResourcePermissions{
+		Permissions: aws.StringSlice([]string{lakeformation.PermissionSelect}),
+		Principal:   principal,
+		Resource: &lakeformation.Resource{
+			TableWithColumns: &lakeformation.TableWithColumnsResource{
+				ColumnWildcard: &lakeformation.ColumnWildcard{},
+				DatabaseName:   aws.String("db"),
+				Name:           aws.String("tbl"),
+			},
+		},
+	}
+	columnsPermission := &lakeformation.PrincipalResourcePermissions{
+		Permissions: aws.StringSlice([]string{lakeformation.PermissionSelect}),
+		Principal:   principal,
+		Resource: &lakeformation.Resource{
+			TableWithColumns: &lakeformation.TableWithColumnsResource{
+				ColumnNames:  aws.StringSlice([]string{"b", "a"}),
+				DatabaseName: aws.String("db"),
+				Name:         aws.String("tbl"),
+			},
+		},
+	}
+	excludedColumnsPermission := &lakeformation.PrincipalResourcePermissions{
+		Permissions: aws.StringSlice([]string{lakeformation.PermissionSelect}),
+		Principal:   principal,
+		Resource: &lakeformation.Resource{
+			TableWithColumns: &lakeformation.TableWithColumnsResource{
+				ColumnWildcard: &lakeformation.ColumnWildcard{
+					ExcludedColumnNames: aws.StringSlice([]string{"c"}),
+				},
+				DatabaseName: aws.String("db"),
+				Name:         aws.String("tbl"),
+			},
+		},
+	}
+	databasePermission := &lakeformation.PrincipalResourcePermissions{
+		Permissions: aws.StringSlice([]string{lakeformation.PermissionCreateTable}),
+		Principal:   principal,
+		Resource: &lakeformation.Resource{
+			Database: &lakeformation.DatabaseResource{
+				Name: aws.String("db"),
+			},
+		},
+	}
+
+	allPermissions := []*lakeformation.PrincipalResourcePermissions{
+		tablePermission,
+		tableSelectPermission,
+		columnsPermission,
+		excludedColumnsPermission,
+		databasePermission,
+	}
+
+	tableInput := &lakeformation.ListPermissionsInput{
+		Resource: &lakeformation.Resource{
+			Table: &lakeformation.TableResource{
+				DatabaseName: aws.String("db"),
+				Name:         aws.String("tbl"),
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name                string
+		Input               *lakeformation.ListPermissionsInput
+		TableType           string
+		ColumnNames         []string
+		ExcludedColumnNames []string
+		ColumnWildcard      bool
+		Expected            []*lakeformation.PrincipalResourcePermissions
+	}{
+		{
+			Name: "database",
+			Input: &lakeformation.ListPermissionsInput{
+				Resource: &lakeformation.Resource{
+					Database: &lakeformation.DatabaseResource{
+						Name: aws.String("db"),
+					},
+				},
+			},
+			Expected: []*lakeformation.PrincipalResourcePermissions{databasePermission},
+		},
+		{
+			Name:      "table",
+			Input:     tableInput,
+			TableType: TableTypeTable,
+			Expected:  []*lakeformation.PrincipalResourcePermissions{tablePermission, tableSelectPermission},
+		},
+		{
+			Name:        "table with column names",
+			Input:       tableInput,
+			TableType:   TableTypeTableWithColumns,
+			ColumnNames: []string{"a", "b"},
+			Expected:    []*lakeformation.PrincipalResourcePermissions{columnsPermission},
+		},
+		{
+			Name:                "table with column wildcard and exclusions",
+			Input:               tableInput,
+			TableType:           TableTypeTableWithColumns,
+			ColumnWildcard:      true,
+			ExcludedColumnNames: []string{"c"},
+			Expected:            []*lakeformation.PrincipalResourcePermissions{excludedColumnsPermission},
+		},
+		{
+			Name:           "table with column wildcard",
+			Input:          tableInput,
+			TableType:      TableTypeTableWithColumns,
+			ColumnWildcard: true,
+			Expected:       []*lakeformation.PrincipalResourcePermissions{tableSelectPermission},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := FilterPermissions(testCase.Input, testCase.TableType, aws.StringSlice(testCase.ColumnNames), aws.StringSlice(testCase.ExcludedColumnNames), testCase.ColumnWildcard, allPermissions)
+
+			if len(got) != len(testCase.Expected) {
+				t.Fatalf("expected %d permissions, got %d: %s", len(testCase.Expected), len(got), got)
+			}
+
+			for i := range got {
+				if got[i] != testCase.Expected[i] {
+					t.Errorf("expected permission %d to be %s, got %s", i, testCase.Expected[i], got[i])
+				}
+			}
+		})
+	}
+}
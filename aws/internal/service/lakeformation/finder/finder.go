@@ -0,0 +1,54 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	tflakeformation "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation"
+)
+
+// Permissions returns the permissions matching the ListPermissions input after
+// filtering out those which do not pertain to the requested resource.
+func Permissions(conn *lakeformation.LakeFormation, input *lakeformation.ListPermissionsInput, tableType string, columnNames []*string, excludedColumnNames []*string, columnWildcard bool) ([]*lakeformation.PrincipalResourcePermissions, error) {
+	var permissions []*lakeformation.PrincipalResourcePermissions
+
+	err := conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, permission := range page.PrincipalResourcePermissions {
+			if permission == nil {
+				continue
+			}
+
+			permissions = append(permissions, permission)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tflakeformation.FilterPermissions(input, tableType, columnNames, excludedColumnNames, columnWildcard, permissions), nil
+}
+
+// Resource returns the registered Lake Formation resource corresponding to the specified ARN.
+func Resource(conn *lakeformation.LakeFormation, resourceArn string) (*lakeformation.ResourceInfo, error) {
+	input := &lakeformation.DescribeResourceInput{
+		ResourceArn: aws.String(resourceArn),
+	}
+
+	output, err := conn.DescribeResource(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.ResourceInfo, nil
+}
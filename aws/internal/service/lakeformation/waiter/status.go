@@ -0,0 +1,29 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation/finder"
+)
+
+const (
+	PermissionsStatusAvailable = "AVAILABLE"
+	PermissionsStatusNotFound  = "NOT_FOUND"
+)
+
+// PermissionsStatus fetches the matching Permissions and whether any are available
+func PermissionsStatus(conn *lakeformation.LakeFormation, input *lakeformation.ListPermissionsInput, tableType string, columnNames []*string, excludedColumnNames []*string, columnWildcard bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		permissions, err := finder.Permissions(conn, input, tableType, columnNames, excludedColumnNames, columnWildcard)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(permissions) == 0 {
+			return permissions, PermissionsStatusNotFound, nil
+		}
+
+		return permissions, PermissionsStatusAvailable, nil
+	}
+}
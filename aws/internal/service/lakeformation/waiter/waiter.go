@@ -0,0 +1,33 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for granted Permissions to be listed
+	PermissionsReadyTimeout = 1 * time.Minute
+)
+
+// PermissionsReady waits for granted Permissions to be returned by ListPermissions
+func PermissionsReady(conn *lakeformation.LakeFormation, input *lakeformation.ListPermissionsInput, tableType string, columnNames []*string, excludedColumnNames []*string, columnWildcard bool) ([]*lakeformation.PrincipalResourcePermissions, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{PermissionsStatusNotFound},
+		Target:                    []string{PermissionsStatusAvailable},
+		Refresh:                   PermissionsStatus(conn, input, tableType, columnNames, excludedColumnNames, columnWildcard),
+		Timeout:                   PermissionsReadyTimeout,
+		MinTimeout:                2 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.([]*lakeformation.PrincipalResourcePermissions); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,35 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+)
+
+// AdminAccountByID returns the Macie organization administrator account corresponding to the specified account ID.
+// Returns nil if no administrator account is found.
+func AdminAccountByID(conn *macie2.Macie2, adminAccountID string) (*macie2.AdminAccount, error) {
+	input := &macie2.ListOrganizationAdminAccountsInput{}
+
+	var result *macie2.AdminAccount
+
+	err := conn.ListOrganizationAdminAccountsPages(input, func(page *macie2.ListOrganizationAdminAccountsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, adminAccount := range page.AdminAccounts {
+			if adminAccount == nil {
+				continue
+			}
+
+			if aws.StringValue(adminAccount.AccountId) == adminAccountID {
+				result = adminAccount
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
@@ -0,0 +1,26 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+)
+
+// FlowByARN returns the MediaConnect flow corresponding to the specified ARN.
+// Returns nil if no flow is found.
+func FlowByARN(conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	input := &mediaconnect.DescribeFlowInput{
+		FlowArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeFlow(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Flow == nil {
+		return nil, nil
+	}
+
+	return output.Flow, nil
+}
@@ -0,0 +1,73 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Flow to return Standby
+	FlowStandbyTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Flow to finish updating
+	FlowUpdatedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Flow to be deleted
+	FlowDeletedTimeout = 10 * time.Minute
+)
+
+// FlowStandby waits for a Flow to return Standby
+func FlowStandby(conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mediaconnect.StatusStarting, mediaconnect.StatusStopping, mediaconnect.StatusUpdating},
+		Target:  []string{mediaconnect.StatusStandby},
+		Refresh: FlowStatus(conn, arn),
+		Timeout: FlowStandbyTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// FlowUpdated waits for a Flow to return Standby or Active
+func FlowUpdated(conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mediaconnect.StatusUpdating},
+		Target:  []string{mediaconnect.StatusStandby, mediaconnect.StatusActive},
+		Refresh: FlowStatus(conn, arn),
+		Timeout: FlowUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// FlowDeleted waits for a Flow to be deleted
+func FlowDeleted(conn *mediaconnect.MediaConnect, arn string) (*mediaconnect.Flow, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{mediaconnect.StatusDeleting},
+		Target:  []string{},
+		Refresh: FlowStatus(conn, arn),
+		Timeout: FlowDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*mediaconnect.Flow); ok {
+		return v, err
+	}
+
+	return nil, err
+}
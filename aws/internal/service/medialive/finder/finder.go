@@ -0,0 +1,86 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+)
+
+// ChannelByID returns the MediaLive channel corresponding to the specified ID.
+// Returns nil if no channel is found.
+func ChannelByID(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	input := &medialive.DescribeChannelInput{
+		ChannelId: aws.String(id),
+	}
+
+	output, err := conn.DescribeChannel(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// InputByID returns the MediaLive input corresponding to the specified ID.
+// Returns nil if no input is found.
+func InputByID(conn *medialive.MediaLive, id string) (*medialive.DescribeInputOutput, error) {
+	input := &medialive.DescribeInputInput{
+		InputId: aws.String(id),
+	}
+
+	output, err := conn.DescribeInput(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// InputSecurityGroupByID returns the MediaLive input security group corresponding to the specified ID.
+// Returns nil if no input security group is found.
+func InputSecurityGroupByID(conn *medialive.MediaLive, id string) (*medialive.DescribeInputSecurityGroupOutput, error) {
+	input := &medialive.DescribeInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(id),
+	}
+
+	output, err := conn.DescribeInputSecurityGroup(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// MultiplexByID returns the MediaLive multiplex corresponding to the specified ID.
+// Returns nil if no multiplex is found.
+func MultiplexByID(conn *medialive.MediaLive, id string) (*medialive.DescribeMultiplexOutput, error) {
+	input := &medialive.DescribeMultiplexInput{
+		MultiplexId: aws.String(id),
+	}
+
+	output, err := conn.DescribeMultiplex(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
@@ -0,0 +1,93 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/finder"
+)
+
+// ChannelState fetches the Channel and its State
+func ChannelState(conn *medialive.MediaLive, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ChannelByID(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || aws.StringValue(output.State) == medialive.ChannelStateDeleted {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// InputState fetches the Input and its State
+func InputState(conn *medialive.MediaLive, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.InputByID(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || aws.StringValue(output.State) == medialive.InputStateDeleted {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// InputSecurityGroupState fetches the InputSecurityGroup and its State
+func InputSecurityGroupState(conn *medialive.MediaLive, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.InputSecurityGroupByID(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || aws.StringValue(output.State) == medialive.InputSecurityGroupStateDeleted {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// MultiplexState fetches the Multiplex and its State
+func MultiplexState(conn *medialive.MediaLive, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.MultiplexByID(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || aws.StringValue(output.State) == medialive.MultiplexStateDeleted {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
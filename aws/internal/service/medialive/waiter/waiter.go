@@ -0,0 +1,262 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Channel to be created
+	ChannelCreatedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for a Channel to be updated
+	ChannelUpdatedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for a Channel to start running
+	ChannelStartedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for a Channel to stop running
+	ChannelStoppedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for a Channel to be deleted
+	ChannelDeletedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for an Input to be created
+	InputCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for an Input to be deleted
+	InputDeletedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for an InputSecurityGroup to be updated
+	InputSecurityGroupUpdatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Multiplex to be created
+	MultiplexCreatedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for a Multiplex to start running
+	MultiplexStartedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for a Multiplex to stop running
+	MultiplexStoppedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for a Multiplex to be deleted
+	MultiplexDeletedTimeout = 15 * time.Minute
+)
+
+// ChannelCreated waits for a Channel to return Idle
+func ChannelCreated(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateCreating},
+		Target:  []string{medialive.ChannelStateIdle},
+		Refresh: ChannelState(conn, id),
+		Timeout: ChannelCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ChannelUpdated waits for a Channel to return Idle
+func ChannelUpdated(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateUpdating},
+		Target:  []string{medialive.ChannelStateIdle},
+		Refresh: ChannelState(conn, id),
+		Timeout: ChannelUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ChannelStarted waits for a Channel to return Running
+func ChannelStarted(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateStarting},
+		Target:  []string{medialive.ChannelStateRunning},
+		Refresh: ChannelState(conn, id),
+		Timeout: ChannelStartedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ChannelStopped waits for a Channel to return Idle
+func ChannelStopped(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateStopping},
+		Target:  []string{medialive.ChannelStateIdle},
+		Refresh: ChannelState(conn, id),
+		Timeout: ChannelStoppedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ChannelDeleted waits for a Channel to be deleted
+func ChannelDeleted(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateDeleting},
+		Target:  []string{},
+		Refresh: ChannelState(conn, id),
+		Timeout: ChannelDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// InputCreated waits for an Input to return Detached
+func InputCreated(conn *medialive.MediaLive, id string) (*medialive.DescribeInputOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputStateCreating},
+		Target:  []string{medialive.InputStateDetached},
+		Refresh: InputState(conn, id),
+		Timeout: InputCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// InputDeleted waits for an Input to be deleted
+func InputDeleted(conn *medialive.MediaLive, id string) (*medialive.DescribeInputOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputStateDeleting},
+		Target:  []string{},
+		Refresh: InputState(conn, id),
+		Timeout: InputDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*medialive.DescribeInputOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// InputSecurityGroupUpdated waits for an InputSecurityGroup to return Idle or InUse
+func InputSecurityGroupUpdated(conn *medialive.MediaLive, id string) (*medialive.DescribeInputSecurityGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputSecurityGroupStateUpdating},
+		Target:  []string{medialive.InputSecurityGroupStateIdle, medialive.InputSecurityGroupStateInUse},
+		Refresh: InputSecurityGroupState(conn, id),
+		Timeout: InputSecurityGroupUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*medialive.DescribeInputSecurityGroupOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// MultiplexCreated waits for a Multiplex to return Idle
+func MultiplexCreated(conn *medialive.MediaLive, id string) (*medialive.DescribeMultiplexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.MultiplexStateCreating},
+		Target:  []string{medialive.MultiplexStateIdle},
+		Refresh: MultiplexState(conn, id),
+		Timeout: MultiplexCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// MultiplexStarted waits for a Multiplex to return Running
+func MultiplexStarted(conn *medialive.MediaLive, id string) (*medialive.DescribeMultiplexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.MultiplexStateStarting},
+		Target:  []string{medialive.MultiplexStateRunning},
+		Refresh: MultiplexState(conn, id),
+		Timeout: MultiplexStartedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// MultiplexStopped waits for a Multiplex to return Idle
+func MultiplexStopped(conn *medialive.MediaLive, id string) (*medialive.DescribeMultiplexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.MultiplexStateStopping},
+		Target:  []string{medialive.MultiplexStateIdle},
+		Refresh: MultiplexState(conn, id),
+		Timeout: MultiplexStoppedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// MultiplexDeleted waits for a Multiplex to be deleted
+func MultiplexDeleted(conn *medialive.MediaLive, id string) (*medialive.DescribeMultiplexOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.MultiplexStateDeleting},
+		Target:  []string{},
+		Refresh: MultiplexState(conn, id),
+		Timeout: MultiplexDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*medialive.DescribeMultiplexOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,198 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+)
+
+// GlobalNetworkByID returns the global network corresponding to the specified ID.
+// Returns nil if no global network is found.
+func GlobalNetworkByID(conn *networkmanager.NetworkManager, globalNetworkID string) (*networkmanager.GlobalNetwork, error) {
+	input := &networkmanager.DescribeGlobalNetworksInput{
+		GlobalNetworkIds: aws.StringSlice([]string{globalNetworkID}),
+	}
+
+	var result *networkmanager.GlobalNetwork
+
+	err := conn.DescribeGlobalNetworksPages(input, func(page *networkmanager.DescribeGlobalNetworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, globalNetwork := range page.GlobalNetworks {
+			if globalNetwork == nil {
+				continue
+			}
+
+			if aws.StringValue(globalNetwork.GlobalNetworkId) == globalNetworkID {
+				result = globalNetwork
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// SiteByID returns the site corresponding to the specified global network and site IDs.
+// Returns nil if no site is found.
+func SiteByID(conn *networkmanager.NetworkManager, globalNetworkID, siteID string) (*networkmanager.Site, error) {
+	input := &networkmanager.GetSitesInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		SiteIds:         aws.StringSlice([]string{siteID}),
+	}
+
+	var result *networkmanager.Site
+
+	err := conn.GetSitesPages(input, func(page *networkmanager.GetSitesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, site := range page.Sites {
+			if site == nil {
+				continue
+			}
+
+			if aws.StringValue(site.SiteId) == siteID {
+				result = site
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// DeviceByID returns the device corresponding to the specified global network and device IDs.
+// Returns nil if no device is found.
+func DeviceByID(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) (*networkmanager.Device, error) {
+	input := &networkmanager.GetDevicesInput{
+		DeviceIds:       aws.StringSlice([]string{deviceID}),
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	var result *networkmanager.Device
+
+	err := conn.GetDevicesPages(input, func(page *networkmanager.GetDevicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, device := range page.Devices {
+			if device == nil {
+				continue
+			}
+
+			if aws.StringValue(device.DeviceId) == deviceID {
+				result = device
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// LinkByID returns the link corresponding to the specified global network and link IDs.
+// Returns nil if no link is found.
+func LinkByID(conn *networkmanager.NetworkManager, globalNetworkID, linkID string) (*networkmanager.Link, error) {
+	input := &networkmanager.GetLinksInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkIds:         aws.StringSlice([]string{linkID}),
+	}
+
+	var result *networkmanager.Link
+
+	err := conn.GetLinksPages(input, func(page *networkmanager.GetLinksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, link := range page.Links {
+			if link == nil {
+				continue
+			}
+
+			if aws.StringValue(link.LinkId) == linkID {
+				result = link
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// LinkAssociationByID returns the association between the specified link and device.
+// Returns nil if no link association is found.
+func LinkAssociationByID(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) (*networkmanager.LinkAssociation, error) {
+	input := &networkmanager.GetLinkAssociationsInput{
+		DeviceId:        aws.String(deviceID),
+		GlobalNetworkId: aws.String(globalNetworkID),
+		LinkId:          aws.String(linkID),
+	}
+
+	var result *networkmanager.LinkAssociation
+
+	err := conn.GetLinkAssociationsPages(input, func(page *networkmanager.GetLinkAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, linkAssociation := range page.LinkAssociations {
+			if linkAssociation == nil {
+				continue
+			}
+
+			if aws.StringValue(linkAssociation.LinkId) == linkID && aws.StringValue(linkAssociation.DeviceId) == deviceID {
+				result = linkAssociation
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// TransitGatewayRegistrationByArn returns the registration of the specified transit gateway with the specified global network.
+// Returns nil if no transit gateway registration is found.
+func TransitGatewayRegistrationByArn(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) (*networkmanager.TransitGatewayRegistration, error) {
+	input := &networkmanager.GetTransitGatewayRegistrationsInput{
+		GlobalNetworkId:    aws.String(globalNetworkID),
+		TransitGatewayArns: aws.StringSlice([]string{transitGatewayARN}),
+	}
+
+	var result *networkmanager.TransitGatewayRegistration
+
+	err := conn.GetTransitGatewayRegistrationsPages(input, func(page *networkmanager.GetTransitGatewayRegistrationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, registration := range page.TransitGatewayRegistrations {
+			if registration == nil {
+				continue
+			}
+
+			if aws.StringValue(registration.TransitGatewayArn) == transitGatewayARN {
+				result = registration
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
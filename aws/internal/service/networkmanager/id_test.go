@@ -0,0 +1,67 @@
+package networkmanager_test
+
+import (
+	"testing"
+
+	tfnetworkmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager"
+)
+
+func TestResourceIDsFromArn(t *testing.T) {
+	testCases := []struct {
+		TestName                string
+		InputARN                string
+		ExpectedError           bool
+		ExpectedGlobalNetworkID string
+		ExpectedResourceID      string
+	}{
+		{
+			TestName:      "empty ARN",
+			InputARN:      "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "unparsable ARN",
+			InputARN:      "test",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "global network ARN",
+			InputARN:      "arn:aws:networkmanager::123456789012:global-network/global-network-01231231231231231",
+			ExpectedError: true,
+		},
+		{
+			TestName:                "site ARN",
+			InputARN:                "arn:aws:networkmanager::123456789012:site/global-network-01231231231231231/site-444555aaabbb11223",
+			ExpectedGlobalNetworkID: "global-network-01231231231231231",
+			ExpectedResourceID:      "site-444555aaabbb11223",
+		},
+		{
+			TestName:                "device ARN",
+			InputARN:                "arn:aws:networkmanager::123456789012:device/global-network-01231231231231231/device-07f6fd08867abc123",
+			ExpectedGlobalNetworkID: "global-network-01231231231231231",
+			ExpectedResourceID:      "device-07f6fd08867abc123",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotGlobalNetworkID, gotResourceID, err := tfnetworkmanager.ResourceIDsFromArn(testCase.InputARN)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotGlobalNetworkID != testCase.ExpectedGlobalNetworkID {
+				t.Errorf("got global network ID %s, expected %s", gotGlobalNetworkID, testCase.ExpectedGlobalNetworkID)
+			}
+
+			if gotResourceID != testCase.ExpectedResourceID {
+				t.Errorf("got resource ID %s, expected %s", gotResourceID, testCase.ExpectedResourceID)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package waiter
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+)
+
+// GlobalNetworkStatus fetches the GlobalNetwork and its State
+func GlobalNetworkStatus(conn *networkmanager.NetworkManager, globalNetworkID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		globalNetwork, err := finder.GlobalNetworkByID(conn, globalNetworkID)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if globalNetwork == nil {
+			return nil, "", nil
+		}
+
+		return globalNetwork, aws.StringValue(globalNetwork.State), nil
+	}
+}
+
+// SiteStatus fetches the Site and its State
+func SiteStatus(conn *networkmanager.NetworkManager, globalNetworkID, siteID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		site, err := finder.SiteByID(conn, globalNetworkID, siteID)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if site == nil {
+			return nil, "", nil
+		}
+
+		return site, aws.StringValue(site.State), nil
+	}
+}
+
+// DeviceStatus fetches the Device and its State
+func DeviceStatus(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		device, err := finder.DeviceByID(conn, globalNetworkID, deviceID)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if device == nil {
+			return nil, "", nil
+		}
+
+		return device, aws.StringValue(device.State), nil
+	}
+}
+
+// LinkStatus fetches the Link and its State
+func LinkStatus(conn *networkmanager.NetworkManager, globalNetworkID, linkID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		link, err := finder.LinkByID(conn, globalNetworkID, linkID)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if link == nil {
+			return nil, "", nil
+		}
+
+		return link, aws.StringValue(link.State), nil
+	}
+}
+
+// LinkAssociationStatus fetches the LinkAssociation and its State
+func LinkAssociationStatus(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		linkAssociation, err := finder.LinkAssociationByID(conn, globalNetworkID, linkID, deviceID)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// A deleted link association may be returned for a short time after deletion.
+		if linkAssociation == nil || aws.StringValue(linkAssociation.LinkAssociationState) == networkmanager.LinkAssociationStateDeleted {
+			return nil, "", nil
+		}
+
+		return linkAssociation, aws.StringValue(linkAssociation.LinkAssociationState), nil
+	}
+}
+
+// TransitGatewayRegistrationStatus fetches the TransitGatewayRegistration and its State
+func TransitGatewayRegistrationStatus(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		registration, err := finder.TransitGatewayRegistrationByArn(conn, globalNetworkID, transitGatewayARN)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if registration == nil || registration.State == nil {
+			return nil, "", nil
+		}
+
+		status := aws.StringValue(registration.State.Code)
+
+		// A deleted registration may be returned for a short time after deregistration.
+		if status == networkmanager.TransitGatewayRegistrationStateDeleted {
+			return nil, "", nil
+		}
+
+		// Error messages can also be contained in the response with FAILED status
+
+		if status == networkmanager.TransitGatewayRegistrationStateFailed {
+			return registration, status, fmt.Errorf("%s", aws.StringValue(registration.State.Message))
+		}
+
+		return registration, status, nil
+	}
+}
@@ -0,0 +1,244 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a GlobalNetwork, Site, Device or Link to return Available
+	AvailableTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a GlobalNetwork, Site, Device or Link to be deleted
+	DeletedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a LinkAssociation to return Available
+	LinkAssociationAvailableTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a LinkAssociation to be deleted
+	LinkAssociationDeletedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a TransitGatewayRegistration to return Available
+	TransitGatewayRegistrationAvailableTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a TransitGatewayRegistration to be deleted
+	TransitGatewayRegistrationDeletedTimeout = 10 * time.Minute
+)
+
+// GlobalNetworkAvailable waits for a GlobalNetwork to return Available
+func GlobalNetworkAvailable(conn *networkmanager.NetworkManager, globalNetworkID string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStatePending, networkmanager.GlobalNetworkStateUpdating},
+		Target:  []string{networkmanager.GlobalNetworkStateAvailable},
+		Refresh: GlobalNetworkStatus(conn, globalNetworkID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// GlobalNetworkDeleted waits for a GlobalNetwork to be deleted
+func GlobalNetworkDeleted(conn *networkmanager.NetworkManager, globalNetworkID string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.GlobalNetworkStateDeleting},
+		Target:  []string{},
+		Refresh: GlobalNetworkStatus(conn, globalNetworkID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.GlobalNetwork); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// SiteAvailable waits for a Site to return Available
+func SiteAvailable(conn *networkmanager.NetworkManager, globalNetworkID, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStatePending, networkmanager.SiteStateUpdating},
+		Target:  []string{networkmanager.SiteStateAvailable},
+		Refresh: SiteStatus(conn, globalNetworkID, siteID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Site); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// SiteDeleted waits for a Site to be deleted
+func SiteDeleted(conn *networkmanager.NetworkManager, globalNetworkID, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.SiteStateDeleting},
+		Target:  []string{},
+		Refresh: SiteStatus(conn, globalNetworkID, siteID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Site); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DeviceAvailable waits for a Device to return Available
+func DeviceAvailable(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStatePending, networkmanager.DeviceStateUpdating},
+		Target:  []string{networkmanager.DeviceStateAvailable},
+		Refresh: DeviceStatus(conn, globalNetworkID, deviceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Device); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DeviceDeleted waits for a Device to be deleted
+func DeviceDeleted(conn *networkmanager.NetworkManager, globalNetworkID, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.DeviceStateDeleting},
+		Target:  []string{},
+		Refresh: DeviceStatus(conn, globalNetworkID, deviceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Device); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// LinkAvailable waits for a Link to return Available
+func LinkAvailable(conn *networkmanager.NetworkManager, globalNetworkID, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStatePending, networkmanager.LinkStateUpdating},
+		Target:  []string{networkmanager.LinkStateAvailable},
+		Refresh: LinkStatus(conn, globalNetworkID, linkID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Link); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// LinkDeleted waits for a Link to be deleted
+func LinkDeleted(conn *networkmanager.NetworkManager, globalNetworkID, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkStateDeleting},
+		Target:  []string{},
+		Refresh: LinkStatus(conn, globalNetworkID, linkID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.Link); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// LinkAssociationAvailable waits for a LinkAssociation to return Available
+func LinkAssociationAvailable(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) (*networkmanager.LinkAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkAssociationStatePending},
+		Target:  []string{networkmanager.LinkAssociationStateAvailable},
+		Refresh: LinkAssociationStatus(conn, globalNetworkID, linkID, deviceID),
+		Timeout: LinkAssociationAvailableTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// LinkAssociationDeleted waits for a LinkAssociation to be deleted
+func LinkAssociationDeleted(conn *networkmanager.NetworkManager, globalNetworkID, linkID, deviceID string) (*networkmanager.LinkAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.LinkAssociationStateDeleting},
+		Target:  []string{},
+		Refresh: LinkAssociationStatus(conn, globalNetworkID, linkID, deviceID),
+		Timeout: LinkAssociationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.LinkAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// TransitGatewayRegistrationAvailable waits for a TransitGatewayRegistration to return Available
+func TransitGatewayRegistrationAvailable(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) (*networkmanager.TransitGatewayRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.TransitGatewayRegistrationStatePending},
+		Target:  []string{networkmanager.TransitGatewayRegistrationStateAvailable},
+		Refresh: TransitGatewayRegistrationStatus(conn, globalNetworkID, transitGatewayARN),
+		Timeout: TransitGatewayRegistrationAvailableTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.TransitGatewayRegistration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// TransitGatewayRegistrationDeleted waits for a TransitGatewayRegistration to be deleted
+func TransitGatewayRegistrationDeleted(conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string) (*networkmanager.TransitGatewayRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.TransitGatewayRegistrationStateDeleting},
+		Target:  []string{},
+		Refresh: TransitGatewayRegistrationStatus(conn, globalNetworkID, transitGatewayARN),
+		Timeout: TransitGatewayRegistrationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*networkmanager.TransitGatewayRegistration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
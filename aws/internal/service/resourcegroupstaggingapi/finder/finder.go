@@ -0,0 +1,46 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+)
+
+// ResourceTagMappingByARNAndTagKey returns the Resource Groups Tagging API resource tag mapping
+// corresponding to the specified resource ARN that has a tag with the specified key.
+// Returns nil if no resource tag mapping is found.
+func ResourceTagMappingByARNAndTagKey(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, arn, key string) (*resourcegroupstaggingapi.ResourceTagMapping, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		TagFilters: []*resourcegroupstaggingapi.TagFilter{
+			{
+				Key: aws.String(key),
+			},
+		},
+	}
+
+	var result *resourcegroupstaggingapi.ResourceTagMapping
+
+	err := conn.GetResourcesPages(input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, resourceTagMapping := range page.ResourceTagMappingList {
+			if resourceTagMapping == nil {
+				continue
+			}
+
+			if aws.StringValue(resourceTagMapping.ResourceARN) == arn {
+				result = resourceTagMapping
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,30 @@
+package waiter
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/resourcegroupstaggingapi/finder"
+)
+
+// TagValueMatchesStatus fetches the resource tag mapping and whether the tag has the specified value
+func TagValueMatchesStatus(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, arn, key, value string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ResourceTagMappingByARNAndTagKey(conn, arn, key)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, strconv.FormatBool(false), nil
+		}
+
+		tags := keyvaluetags.ResourcegroupstaggingapiKeyValueTags(output.Tags)
+
+		return output, strconv.FormatBool(tags.KeyExists(key) && aws.StringValue(tags.KeyValue(key)) == value), nil
+	}
+}
@@ -0,0 +1,33 @@
+package waiter
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a tag to become visible through the Resource Groups Tagging API
+	TagPropagationTimeout = 2 * time.Minute
+)
+
+// TagPropagated waits for a tag with the specified value to become visible through the Resource Groups Tagging API
+func TagPropagated(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, arn, key, value string) (*resourcegroupstaggingapi.ResourceTagMapping, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{strconv.FormatBool(false)},
+		Target:                    []string{strconv.FormatBool(true)},
+		Refresh:                   TagValueMatchesStatus(conn, arn, key, value),
+		Timeout:                   TagPropagationTimeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*resourcegroupstaggingapi.ResourceTagMapping); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,38 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+)
+
+// DomainDetailByName returns the Route 53 Domains domain detail corresponding to the specified domain name.
+// Returns nil if no domain is found.
+func DomainDetailByName(conn *route53domains.Route53Domains, domainName string) (*route53domains.GetDomainDetailOutput, error) {
+	input := &route53domains.GetDomainDetailInput{
+		DomainName: aws.String(domainName),
+	}
+
+	output, err := conn.GetDomainDetail(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// OperationDetailByID returns the Route 53 Domains operation detail corresponding to the specified operation ID.
+// Returns nil if no operation is found.
+func OperationDetailByID(conn *route53domains.Route53Domains, operationID string) (*route53domains.GetOperationDetailOutput, error) {
+	input := &route53domains.GetOperationDetailInput{
+		OperationId: aws.String(operationID),
+	}
+
+	output, err := conn.GetOperationDetail(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
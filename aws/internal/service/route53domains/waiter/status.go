@@ -0,0 +1,33 @@
+package waiter
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53domains/finder"
+)
+
+// OperationStatus fetches the Operation and its Status
+func OperationStatus(conn *route53domains.Route53Domains, operationID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.OperationDetailByID(conn, operationID)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		status := aws.StringValue(output.Status)
+
+		if status == route53domains.OperationStatusError || status == route53domains.OperationStatusFailed {
+			return output, status, fmt.Errorf("%s", aws.StringValue(output.Message))
+		}
+
+		return output, status, nil
+	}
+}
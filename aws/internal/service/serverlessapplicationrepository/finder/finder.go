@@ -0,0 +1,26 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+)
+
+// ApplicationByID returns the Serverless Application Repository application corresponding to the specified ID and semantic version.
+// The latest version is returned if no semantic version is specified.
+func ApplicationByID(conn *serverlessapplicationrepository.ServerlessApplicationRepository, applicationID, semanticVersion string) (*serverlessapplicationrepository.GetApplicationOutput, error) {
+	input := &serverlessapplicationrepository.GetApplicationInput{
+		ApplicationId: aws.String(applicationID),
+	}
+
+	if semanticVersion != "" {
+		input.SemanticVersion = aws.String(semanticVersion)
+	}
+
+	output, err := conn.GetApplication(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
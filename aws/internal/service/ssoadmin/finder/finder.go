@@ -0,0 +1,113 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+)
+
+// AccountAssignment returns the account assigned to a permission set within a specified SSO instance.
+// Returns nil if no account assignment is found.
+func AccountAssignment(conn *ssoadmin.SSOAdmin, principalID, principalType, accountID, permissionSetArn, instanceArn string) (*ssoadmin.AccountAssignment, error) {
+	input := &ssoadmin.ListAccountAssignmentsInput{
+		AccountId:        aws.String(accountID),
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	var accountAssignment *ssoadmin.AccountAssignment
+
+	err := conn.ListAccountAssignmentsPages(input, func(page *ssoadmin.ListAccountAssignmentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, a := range page.AccountAssignments {
+			if a == nil {
+				continue
+			}
+
+			if aws.StringValue(a.PrincipalType) != principalType {
+				continue
+			}
+
+			if aws.StringValue(a.PrincipalId) == principalID {
+				accountAssignment = a
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return accountAssignment, err
+}
+
+// InlinePolicy returns the inline policy of a permission set within a specified SSO instance.
+// Returns an empty string if no inline policy is found.
+func InlinePolicy(conn *ssoadmin.SSOAdmin, permissionSetArn, instanceArn string) (string, error) {
+	input := &ssoadmin.GetInlinePolicyForPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	output, err := conn.GetInlinePolicyForPermissionSet(input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", nil
+	}
+
+	return aws.StringValue(output.InlinePolicy), nil
+}
+
+// ManagedPolicy returns the managed policy attached to a permission set within a specified SSO instance.
+// Returns nil if no managed policy is found.
+func ManagedPolicy(conn *ssoadmin.SSOAdmin, managedPolicyArn, permissionSetArn, instanceArn string) (*ssoadmin.AttachedManagedPolicy, error) {
+	input := &ssoadmin.ListManagedPoliciesInPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	var attachedPolicy *ssoadmin.AttachedManagedPolicy
+
+	err := conn.ListManagedPoliciesInPermissionSetPages(input, func(page *ssoadmin.ListManagedPoliciesInPermissionSetOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, policy := range page.AttachedManagedPolicies {
+			if aws.StringValue(policy.Arn) == managedPolicyArn {
+				attachedPolicy = policy
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return attachedPolicy, err
+}
+
+// PermissionSet returns the permission set corresponding to the specified ARN within a specified SSO instance.
+// Returns nil if no permission set is found.
+func PermissionSet(conn *ssoadmin.SSOAdmin, permissionSetArn, instanceArn string) (*ssoadmin.PermissionSet, error) {
+	input := &ssoadmin.DescribePermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	output, err := conn.DescribePermissionSet(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.PermissionSet, nil
+}
@@ -0,0 +1,63 @@
+package ssoadmin
+
+import (
+	"fmt"
+	"strings"
+)
+
+const resourceIDSeparator = ","
+
+// PermissionSetCreateResourceID returns a permission set resource ID.
+func PermissionSetCreateResourceID(permissionSetArn, instanceArn string) string {
+	return strings.Join([]string{permissionSetArn, instanceArn}, resourceIDSeparator)
+}
+
+// PermissionSetParseResourceID parses a permission set resource ID into its permission set ARN and instance ARN.
+func PermissionSetParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected PERMISSION_SET_ARN%[2]sINSTANCE_ARN", id, resourceIDSeparator)
+}
+
+// ManagedPolicyAttachmentCreateResourceID returns a managed policy attachment resource ID.
+func ManagedPolicyAttachmentCreateResourceID(managedPolicyArn, permissionSetArn, instanceArn string) string {
+	return strings.Join([]string{managedPolicyArn, permissionSetArn, instanceArn}, resourceIDSeparator)
+}
+
+// ManagedPolicyAttachmentParseResourceID parses a managed policy attachment resource ID into its managed policy ARN, permission set ARN and instance ARN.
+func ManagedPolicyAttachmentParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected MANAGED_POLICY_ARN%[2]sPERMISSION_SET_ARN%[2]sINSTANCE_ARN", id, resourceIDSeparator)
+}
+
+// AccountAssignmentCreateResourceID returns an account assignment resource ID.
+func AccountAssignmentCreateResourceID(principalID, principalType, targetID, targetType, permissionSetArn, instanceArn string) string {
+	return strings.Join([]string{principalID, principalType, targetID, targetType, permissionSetArn, instanceArn}, resourceIDSeparator)
+}
+
+// AccountAssignmentParseResourceID parses an account assignment resource ID into its
+// principal ID, principal type, target ID, target type, permission set ARN and instance ARN.
+func AccountAssignmentParseResourceID(id string) (string, string, string, string, string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 6 {
+		for _, part := range parts {
+			if part == "" {
+				return "", "", "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected PRINCIPAL_ID%[2]sPRINCIPAL_TYPE%[2]sTARGET_ID%[2]sTARGET_TYPE%[2]sPERMISSION_SET_ARN%[2]sINSTANCE_ARN", id, resourceIDSeparator)
+			}
+		}
+
+		return parts[0], parts[1], parts[2], parts[3], parts[4], parts[5], nil
+	}
+
+	return "", "", "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected PRINCIPAL_ID%[2]sPRINCIPAL_TYPE%[2]sTARGET_ID%[2]sTARGET_TYPE%[2]sPERMISSION_SET_ARN%[2]sINSTANCE_ARN", id, resourceIDSeparator)
+}
@@ -0,0 +1,93 @@
+package waiter
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// AccountAssignmentCreationStatus fetches the Account Assignment creation request and its Status
+func AccountAssignmentCreationStatus(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ssoadmin.DescribeAccountAssignmentCreationStatusInput{
+			AccountAssignmentCreationRequestId: aws.String(requestID),
+			InstanceArn:                        aws.String(instanceArn),
+		}
+
+		output, err := conn.DescribeAccountAssignmentCreationStatus(input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.AccountAssignmentCreationStatus == nil {
+			return nil, "", nil
+		}
+
+		return accountAssignmentOperationStatus(output.AccountAssignmentCreationStatus)
+	}
+}
+
+// AccountAssignmentDeletionStatus fetches the Account Assignment deletion request and its Status
+func AccountAssignmentDeletionStatus(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ssoadmin.DescribeAccountAssignmentDeletionStatusInput{
+			AccountAssignmentDeletionRequestId: aws.String(requestID),
+			InstanceArn:                        aws.String(instanceArn),
+		}
+
+		output, err := conn.DescribeAccountAssignmentDeletionStatus(input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.AccountAssignmentDeletionStatus == nil {
+			return nil, "", nil
+		}
+
+		return accountAssignmentOperationStatus(output.AccountAssignmentDeletionStatus)
+	}
+}
+
+// PermissionSetProvisioningStatus fetches the Permission Set provisioning request and its Status
+func PermissionSetProvisioningStatus(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ssoadmin.DescribePermissionSetProvisioningStatusInput{
+			InstanceArn:                     aws.String(instanceArn),
+			ProvisionPermissionSetRequestId: aws.String(requestID),
+		}
+
+		output, err := conn.DescribePermissionSetProvisioningStatus(input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.PermissionSetProvisioningStatus == nil {
+			return nil, "", nil
+		}
+
+		status := output.PermissionSetProvisioningStatus
+
+		// Error messages can also be contained in the response with FAILED status
+
+		if aws.StringValue(status.Status) == ssoadmin.StatusValuesFailed {
+			return status, ssoadmin.StatusValuesFailed, fmt.Errorf("%s", aws.StringValue(status.FailureReason))
+		}
+
+		return status, aws.StringValue(status.Status), nil
+	}
+}
+
+func accountAssignmentOperationStatus(status *ssoadmin.AccountAssignmentOperationStatus) (interface{}, string, error) {
+	// Error messages can also be contained in the response with FAILED status
+
+	if aws.StringValue(status.Status) == ssoadmin.StatusValuesFailed {
+		return status, ssoadmin.StatusValuesFailed, fmt.Errorf("%s", aws.StringValue(status.FailureReason))
+	}
+
+	return status, aws.StringValue(status.Status), nil
+}
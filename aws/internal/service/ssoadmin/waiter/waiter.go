@@ -0,0 +1,85 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for an Account Assignment to be created
+	AccountAssignmentCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for an Account Assignment to be deleted
+	AccountAssignmentDeletedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Permission Set to be provisioned
+	PermissionSetProvisionedTimeout = 10 * time.Minute
+
+	// Delay before the first status check of an asynchronous request
+	statusDelay = 5 * time.Second
+
+	// Minimum delay between status checks of an asynchronous request
+	statusMinTimeout = 3 * time.Second
+)
+
+// AccountAssignmentCreated waits for an Account Assignment creation request to succeed
+func AccountAssignmentCreated(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.AccountAssignmentOperationStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ssoadmin.StatusValuesInProgress},
+		Target:     []string{ssoadmin.StatusValuesSucceeded},
+		Refresh:    AccountAssignmentCreationStatus(conn, instanceArn, requestID),
+		Timeout:    AccountAssignmentCreatedTimeout,
+		Delay:      statusDelay,
+		MinTimeout: statusMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// AccountAssignmentDeleted waits for an Account Assignment deletion request to succeed
+func AccountAssignmentDeleted(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.AccountAssignmentOperationStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ssoadmin.StatusValuesInProgress},
+		Target:     []string{ssoadmin.StatusValuesSucceeded},
+		Refresh:    AccountAssignmentDeletionStatus(conn, instanceArn, requestID),
+		Timeout:    AccountAssignmentDeletedTimeout,
+		Delay:      statusDelay,
+		MinTimeout: statusMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// PermissionSetProvisioned waits for a Permission Set provisioning request to succeed
+func PermissionSetProvisioned(conn *ssoadmin.SSOAdmin, instanceArn, requestID string) (*ssoadmin.PermissionSetProvisioningStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ssoadmin.StatusValuesInProgress},
+		Target:     []string{ssoadmin.StatusValuesSucceeded},
+		Refresh:    PermissionSetProvisioningStatus(conn, instanceArn, requestID),
+		Timeout:    PermissionSetProvisionedTimeout,
+		Delay:      statusDelay,
+		MinTimeout: statusMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ssoadmin.PermissionSetProvisioningStatus); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,26 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+)
+
+// CanaryByName returns the canary corresponding to the specified name.
+// Returns nil if no canary is found.
+func CanaryByName(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	input := &synthetics.GetCanaryInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetCanary(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Canary, nil
+}
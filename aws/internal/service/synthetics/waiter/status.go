@@ -0,0 +1,38 @@
+package waiter
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/synthetics/finder"
+)
+
+// CanaryStatus fetches the Canary and its Status
+func CanaryStatus(conn *synthetics.Synthetics, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		canary, err := finder.CanaryByName(conn, name)
+
+		if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeResourceNotFoundException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if canary == nil || canary.Status == nil {
+			return nil, "", nil
+		}
+
+		// Error messages can also be contained in the response with ERROR status
+
+		if aws.StringValue(canary.Status.State) == synthetics.CanaryStateError {
+			return canary, synthetics.CanaryStateError, fmt.Errorf("%s: %s", aws.StringValue(canary.Status.StateReasonCode), aws.StringValue(canary.Status.StateReason))
+		}
+
+		return canary, aws.StringValue(canary.Status.State), nil
+	}
+}
@@ -0,0 +1,115 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Canary to return Ready
+	CanaryCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Canary update to complete
+	CanaryUpdatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Canary to return Running
+	CanaryRunningTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Canary to return Stopped
+	CanaryStoppedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Canary to be deleted
+	CanaryDeletedTimeout = 5 * time.Minute
+)
+
+// CanaryReady waits for a Canary to return Ready
+func CanaryReady(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateCreating, synthetics.CanaryStateUpdating},
+		Target:  []string{synthetics.CanaryStateReady},
+		Refresh: CanaryStatus(conn, name),
+		Timeout: CanaryCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// CanaryUpdated waits for a Canary update to complete
+func CanaryUpdated(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateUpdating},
+		Target:  []string{synthetics.CanaryStateReady, synthetics.CanaryStateRunning, synthetics.CanaryStateStopped},
+		Refresh: CanaryStatus(conn, name),
+		Timeout: CanaryUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// CanaryRunning waits for a Canary to return Running
+func CanaryRunning(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateStarting, synthetics.CanaryStateUpdating, synthetics.CanaryStateReady, synthetics.CanaryStateStopped},
+		Target:  []string{synthetics.CanaryStateRunning},
+		Refresh: CanaryStatus(conn, name),
+		Timeout: CanaryRunningTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// CanaryStopped waits for a Canary to return Stopped
+func CanaryStopped(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateStopping, synthetics.CanaryStateUpdating, synthetics.CanaryStateRunning},
+		Target:  []string{synthetics.CanaryStateStopped},
+		Refresh: CanaryStatus(conn, name),
+		Timeout: CanaryStoppedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// CanaryDeleted waits for a Canary to be deleted
+func CanaryDeleted(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateDeleting, synthetics.CanaryStateStopped, synthetics.CanaryStateReady},
+		Target:  []string{},
+		Refresh: CanaryStatus(conn, name),
+		Timeout: CanaryDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,47 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+)
+
+// DatabaseByName returns the database corresponding to the specified name.
+// Returns nil if no database is found.
+func DatabaseByName(conn *timestreamwrite.TimestreamWrite, name string) (*timestreamwrite.Database, error) {
+	input := &timestreamwrite.DescribeDatabaseInput{
+		DatabaseName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDatabase(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Database, nil
+}
+
+// TableByNameAndDatabaseName returns the table corresponding to the specified table and database names.
+// Returns nil if no table is found.
+func TableByNameAndDatabaseName(conn *timestreamwrite.TimestreamWrite, tableName, databaseName string) (*timestreamwrite.Table, error) {
+	input := &timestreamwrite.DescribeTableInput{
+		DatabaseName: aws.String(databaseName),
+		TableName:    aws.String(tableName),
+	}
+
+	output, err := conn.DescribeTable(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Table, nil
+}
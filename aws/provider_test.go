@@ -791,6 +791,33 @@ func testAccMultipleRegionProviderConfig(regions int) string {
 	return config.String()
 }
 
+func testAccProviderConfigDefaultTags_Tags1(tag1, value1 string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %[1]q = %[2]q
+    }
+  }
+}
+`, tag1, value1)
+}
+
+func testAccProviderConfigDefaultTags_Tags2(tag1, value1, tag2, value2 string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %[1]q = %[2]q
+      %[3]q = %[4]q
+    }
+  }
+}
+`, tag1, value1, tag2, value2)
+}
+
 func testAccProviderConfigIgnoreTagsKeyPrefixes1(keyPrefix1 string) string {
 	//lintignore:AT004
 	return fmt.Sprintf(`
@@ -1017,6 +1044,123 @@ func TestAccAWSProvider_Endpoints(t *testing.T) {
 	})
 }
 
+func TestAccAWSProvider_DefaultTags_EmptyConfigurationBlock(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigDefaultTagsEmptyConfigurationBlock(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSProviderDefaultTags_Tags(&providers, map[string]string{}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_DefaultTags_Tags_None(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigDefaultTags_Tags0(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSProviderDefaultTags_Tags(&providers, map[string]string{}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_DefaultTags_Tags_One(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigDefaultTags_Tags1("test", "value"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSProviderDefaultTags_Tags(&providers, map[string]string{"test": "value"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_DefaultTags_Tags_Multiple(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigDefaultTags_Tags2("test1", "value1", "test2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSProviderDefaultTags_Tags(&providers, map[string]string{
+						"test1": "value1",
+						"test2": "value2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_DefaultTags_UnsupportedResource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSProviderConfigDefaultTagsUnsupportedResource("test", "value"),
+				ExpectError: regexp.MustCompile(`aws_kms_key does not support the provider default_tags configuration`),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_RequiredTags_MissingKeys(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSProviderConfigRequiredTags("Owner", "test", "Environment", "prod"),
+				ExpectError: regexp.MustCompile(`aws_vpc does not satisfy the provider required_tags configuration: missing tag keys \(CostCenter\)`),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_RequiredTags_InvalidValues(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSProviderConfigRequiredTags("CostCenter", "1234", "Environment", "qa"),
+				ExpectError: regexp.MustCompile(`aws_vpc does not satisfy the provider required_tags configuration: tag "Environment" value "qa" is not one of \(dev, prod\)`),
+			},
+		},
+	})
+}
+
 func TestAccAWSProvider_IgnoreTags_EmptyConfigurationBlock(t *testing.T) {
 	var providers []*schema.Provider
 
@@ -1317,6 +1461,51 @@ func testAccCheckAWSProviderEndpoints(providers *[]*schema.Provider) resource.Te
 	}
 }
 
+func testAccCheckAWSProviderDefaultTags_Tags(providers *[]*schema.Provider, expectedTags map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if providers == nil {
+			return fmt.Errorf("no providers initialized")
+		}
+
+		for _, provider := range *providers {
+			if provider == nil || provider.Meta() == nil || provider.Meta().(*AWSClient) == nil {
+				continue
+			}
+
+			providerClient := provider.Meta().(*AWSClient)
+			defaultTagsConfig := providerClient.DefaultTagsConfig
+
+			if defaultTagsConfig == nil || len(defaultTagsConfig.Tags) == 0 {
+				if len(expectedTags) != 0 {
+					return fmt.Errorf("expected tags (%d) length, got: 0", len(expectedTags))
+				}
+
+				continue
+			}
+
+			actualTags := defaultTagsConfig.Tags.Map()
+
+			if len(actualTags) != len(expectedTags) {
+				return fmt.Errorf("expected tags (%d) length, got: %d", len(expectedTags), len(actualTags))
+			}
+
+			for expectedKey, expectedValue := range expectedTags {
+				actualValue, ok := actualTags[expectedKey]
+
+				if !ok {
+					return fmt.Errorf("expected tags element, but was missing: %s", expectedKey)
+				}
+
+				if actualValue != expectedValue {
+					return fmt.Errorf("expected tags element (%s) value (%s), got: %s", expectedKey, expectedValue, actualValue)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSProviderIgnoreTagsKeyPrefixes(providers *[]*schema.Provider, expectedKeyPrefixes []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if providers == nil {
@@ -1540,6 +1729,149 @@ data "aws_arn" "test" {
 `, endpoints)
 }
 
+func testAccAWSProviderConfigDefaultTagsEmptyConfigurationBlock() string {
+	//lintignore:AT004
+	return `
+provider "aws" {
+  default_tags {}
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+
+data "aws_partition" "provider_test" {}
+
+# Required to initialize the provider
+data "aws_arn" "test" {
+  arn = "arn:${data.aws_partition.provider_test.partition}:s3:::test"
+}
+`
+}
+
+func testAccAWSProviderConfigDefaultTags_Tags0() string {
+	//lintignore:AT004
+	return `
+provider "aws" {
+  default_tags {
+    tags = {}
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+
+data "aws_partition" "provider_test" {}
+
+# Required to initialize the provider
+data "aws_arn" "test" {
+  arn = "arn:${data.aws_partition.provider_test.partition}:s3:::test"
+}
+`
+}
+
+func testAccAWSProviderConfigDefaultTags_Tags1(tag1, value1 string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %[1]q = %[2]q
+    }
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+
+data "aws_partition" "provider_test" {}
+
+# Required to initialize the provider
+data "aws_arn" "test" {
+  arn = "arn:${data.aws_partition.provider_test.partition}:s3:::test"
+}
+`, tag1, value1)
+}
+
+func testAccAWSProviderConfigDefaultTagsUnsupportedResource(tag1, value1 string) string {
+	return composeConfig(
+		testAccProviderConfigDefaultTags_Tags1(tag1, value1),
+		`
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+}
+`)
+}
+
+func testAccAWSProviderConfigDefaultTags_Tags2(tag1, value1, tag2, value2 string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %[1]q = %[2]q
+      %[3]q = %[4]q
+    }
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+
+data "aws_partition" "provider_test" {}
+
+# Required to initialize the provider
+data "aws_arn" "test" {
+  arn = "arn:${data.aws_partition.provider_test.partition}:s3:::test"
+}
+`, tag1, value1, tag2, value2)
+}
+
+// testAccAWSProviderConfigRequiredTags requires CostCenter and Owner tags,
+// with Owner defaulted by the provider.
+func testAccAWSProviderConfigRequiredTags(tag1, value1, tag2, value2 string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      Owner = "default"
+    }
+  }
+
+  required_tags {
+    keys = ["CostCenter", "Owner"]
+
+    allowed_values {
+      key    = "Environment"
+      values = ["dev", "prod"]
+    }
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tag1, value1, tag2, value2)
+}
+
 func testAccAWSProviderConfigIgnoreTagsEmptyConfigurationBlock() string {
 	//lintignore:AT004
 	return `
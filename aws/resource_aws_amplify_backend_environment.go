@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfamplify "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/amplify"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/amplify/finder"
+)
+
+func resourceAwsAmplifyBackendEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAmplifyBackendEnvironmentCreate,
+		Read:   resourceAwsAmplifyBackendEnvironmentRead,
+		Delete: resourceAwsAmplifyBackendEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"deployment_artifacts": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 1000),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]+$`), "should only contain letters, numbers, and -"),
+				),
+			},
+
+			"environment_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 10),
+					validation.StringMatch(regexp.MustCompile(`^[a-z]+$`), "should only contain lowercase alphabets"),
+				),
+			},
+
+			"stack_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]*$`), "should start with a letter and only contain letters, numbers, and -"),
+				),
+			},
+		},
+	}
+}
+
+func resourceAwsAmplifyBackendEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	appID := d.Get("app_id").(string)
+	environmentName := d.Get("environment_name").(string)
+	id := tfamplify.BackendEnvironmentCreateResourceID(appID, environmentName)
+
+	input := &amplify.CreateBackendEnvironmentInput{
+		AppId:           aws.String(appID),
+		EnvironmentName: aws.String(environmentName),
+	}
+
+	if v, ok := d.GetOk("deployment_artifacts"); ok {
+		input.DeploymentArtifacts = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("stack_name"); ok {
+		input.StackName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Amplify Backend Environment: %s", input)
+	_, err := conn.CreateBackendEnvironment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Amplify Backend Environment (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsAmplifyBackendEnvironmentRead(d, meta)
+}
+
+func resourceAwsAmplifyBackendEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	appID, environmentName, err := tfamplify.BackendEnvironmentParseResourceID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing Amplify Backend Environment ID: %w", err)
+	}
+
+	backendEnvironment, err := finder.BackendEnvironmentByAppIDAndEnvironmentName(conn, appID, environmentName)
+
+	if !d.IsNewResource() && isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Amplify Backend Environment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Amplify Backend Environment (%s): %w", d.Id(), err)
+	}
+
+	if backendEnvironment == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Amplify Backend Environment (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] Amplify Backend Environment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("app_id", appID)
+	d.Set("arn", backendEnvironment.BackendEnvironmentArn)
+	d.Set("deployment_artifacts", backendEnvironment.DeploymentArtifacts)
+	d.Set("environment_name", backendEnvironment.EnvironmentName)
+	d.Set("stack_name", backendEnvironment.StackName)
+
+	return nil
+}
+
+func resourceAwsAmplifyBackendEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).amplifyconn
+
+	appID, environmentName, err := tfamplify.BackendEnvironmentParseResourceID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing Amplify Backend Environment ID: %w", err)
+	}
+
+	log.Printf("[DEBUG] Deleting Amplify Backend Environment (%s)", d.Id())
+	_, err = conn.DeleteBackendEnvironment(&amplify.DeleteBackendEnvironmentInput{
+		AppId:           aws.String(appID),
+		EnvironmentName: aws.String(environmentName),
+	})
+
+	if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Amplify Backend Environment (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/amplify/finder"
+)
+
+func TestAmplifyWebhookAppIDFromArn(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputArn      string
+		ExpectedError *regexp.Regexp
+		ExpectedAppID string
+	}{
+		{
+			TestName:      "empty ARN",
+			InputArn:      "",
+			ExpectedError: regexp.MustCompile(`error parsing Amplify Webhook ARN`),
+		},
+		{
+			TestName:      "unexpected resource",
+			InputArn:      "arn:aws:amplify:us-west-2:123456789012:apps/d2ypk4k47z8u6/branches/main",
+			ExpectedError: regexp.MustCompile(`unexpected format for Amplify Webhook ARN`),
+		},
+		{
+			TestName:      "valid ARN",
+			InputArn:      "arn:aws:amplify:us-west-2:123456789012:apps/d2ypk4k47z8u6/webhooks/a8f1c2d4-0f3b-4c2e-9c7d-8e0f1a2b3c4d",
+			ExpectedAppID: "d2ypk4k47z8u6",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := amplifyWebhookAppIDFromArn(testCase.InputArn)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if got != testCase.ExpectedAppID {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedAppID)
+			}
+		})
+	}
+}
+
+func TestAccAWSAmplifyWebhook_basic(t *testing.T) {
+	var webhook amplify.Webhook
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_amplify_webhook.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAmplify(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyWebhookConfig(rName, "aws_amplify_branch.test1", "testdescription1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAmplifyWebhookExists(resourceName, &webhook),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "amplify", regexp.MustCompile(`apps/.+/webhooks/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "app_id", "aws_amplify_app.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "branch_name", fmt.Sprintf("%s-1", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", "testdescription1"),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile(`^https://webhooks.amplify.`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAmplifyWebhookConfig(rName, "aws_amplify_branch.test2", "testdescription2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAmplifyWebhookExists(resourceName, &webhook),
+					resource.TestCheckResourceAttr(resourceName, "branch_name", fmt.Sprintf("%s-2", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", "testdescription2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAmplifyWebhook_disappears(t *testing.T) {
+	var webhook amplify.Webhook
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_amplify_webhook.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSAmplify(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAmplifyWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAmplifyWebhookConfig(rName, "aws_amplify_branch.test1", "testdescription1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAmplifyWebhookExists(resourceName, &webhook),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsAmplifyWebhook(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAmplifyWebhookExists(resourceName string, v *amplify.Webhook) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Amplify Webhook ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+		webhook, err := finder.WebhookByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if webhook == nil {
+			return fmt.Errorf("Amplify Webhook (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *webhook
+
+		return nil
+	}
+}
+
+func testAccCheckAWSAmplifyWebhookDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_amplify_webhook" {
+			continue
+		}
+
+		_, err := finder.WebhookByID(conn, rs.Primary.ID)
+
+		if isAWSErr(err, amplify.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Amplify Webhook %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSAmplifyWebhookConfig(rName, branchResourceName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_amplify_branch" "test1" {
+  app_id      = aws_amplify_app.test.id
+  branch_name = "%[1]s-1"
+}
+
+resource "aws_amplify_branch" "test2" {
+  app_id      = aws_amplify_app.test.id
+  branch_name = "%[1]s-2"
+}
+
+resource "aws_amplify_webhook" "test" {
+  app_id      = aws_amplify_app.test.id
+  branch_name = %[2]s.branch_name
+  description = %[3]q
+}
+`, rName, branchResourceName, description)
+}
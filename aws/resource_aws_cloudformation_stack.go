@@ -723,3 +723,68 @@ func waitForCloudFormationStackDeletion(conn *cloudformation.CloudFormation, sta
 
 	return err
 }
+
+func waitForCloudFormationStackUpdate(conn *cloudformation.CloudFormation, stackId string, timeout time.Duration) (string, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudformation.StackStatusUpdateCompleteCleanupInProgress,
+			cloudformation.StackStatusUpdateInProgress,
+			cloudformation.StackStatusUpdateRollbackInProgress,
+			cloudformation.StackStatusUpdateRollbackCompleteCleanupInProgress,
+		},
+		Target: []string{
+			cloudformation.StackStatusCreateComplete,
+			cloudformation.StackStatusUpdateComplete,
+			cloudformation.StackStatusUpdateRollbackComplete,
+			cloudformation.StackStatusUpdateRollbackFailed,
+		},
+		Refresh:    cfStackStateRefresh(conn, stackId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	v, err := stateConf.WaitForState()
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(v.(*cloudformation.Stack).StackStatus), nil
+}
+
+func cfChangeSetStateRefresh(conn *cloudformation.CloudFormation, changeSetId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+			ChangeSetName: aws.String(changeSetId),
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("error describing CloudFormation change set: %s", err)
+		}
+
+		return resp, aws.StringValue(resp.Status), nil
+	}
+}
+
+func waitForCloudFormationChangeSetCreation(conn *cloudformation.CloudFormation, changeSetId string, timeout time.Duration) (*cloudformation.DescribeChangeSetOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudformation.ChangeSetStatusCreateInProgress,
+			cloudformation.ChangeSetStatusCreatePending,
+		},
+		Target: []string{
+			cloudformation.ChangeSetStatusCreateComplete,
+			cloudformation.ChangeSetStatusFailed,
+		},
+		Refresh:    cfChangeSetStateRefresh(conn, changeSetId),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	v, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*cloudformation.DescribeChangeSetOutput), nil
+}
@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/codestarconnections/finder"
+)
+
+func resourceAwsCodeStarConnectionsConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCodeStarConnectionsConnectionCreate,
+		Read:   resourceAwsCodeStarConnectionsConnectionRead,
+		Update: resourceAwsCodeStarConnectionsConnectionUpdate,
+		Delete: resourceAwsCodeStarConnectionsConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"host_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+				ExactlyOneOf: []string{"host_arn", "provider_type"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"provider_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(codestarconnections.ProviderType_Values(), false),
+				ExactlyOneOf: []string{"host_arn", "provider_type"},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsCodeStarConnectionsConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codestarconnectionsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &codestarconnections.CreateConnectionInput{
+		ConnectionName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("host_arn"); ok {
+		input.HostArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provider_type"); ok {
+		input.ProviderType = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().CodestarconnectionsTags()
+	}
+
+	log.Printf("[DEBUG] Creating CodeStar Connections Connection: %s", input)
+	output, err := conn.CreateConnection(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CodeStar Connections Connection (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ConnectionArn))
+
+	return resourceAwsCodeStarConnectionsConnectionRead(d, meta)
+}
+
+func resourceAwsCodeStarConnectionsConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codestarconnectionsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	connection, err := finder.ConnectionByArn(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, codestarconnections.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] CodeStar Connections Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CodeStar Connections Connection (%s): %w", d.Id(), err)
+	}
+
+	if connection == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading CodeStar Connections Connection (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] CodeStar Connections Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(connection.ConnectionArn)
+	d.Set("arn", arn)
+	d.Set("connection_status", connection.ConnectionStatus)
+	d.Set("host_arn", connection.HostArn)
+	d.Set("name", connection.ConnectionName)
+	d.Set("provider_type", connection.ProviderType)
+
+	tags, err := keyvaluetags.CodestarconnectionsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for CodeStar Connections Connection (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCodeStarConnectionsConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codestarconnectionsconn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.CodestarconnectionsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating CodeStar Connections Connection (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsCodeStarConnectionsConnectionRead(d, meta)
+}
+
+func resourceAwsCodeStarConnectionsConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codestarconnectionsconn
+
+	log.Printf("[DEBUG] Deleting CodeStar Connections Connection: %s", d.Id())
+	_, err := conn.DeleteConnection(&codestarconnections.DeleteConnectionInput{
+		ConnectionArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, codestarconnections.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CodeStar Connections Connection (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
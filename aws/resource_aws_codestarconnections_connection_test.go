@@ -0,0 +1,308 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/codestarconnections/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_codestarconnections_connection", &resource.Sweeper{
+		Name: "aws_codestarconnections_connection",
+		F:    testSweepCodeStarConnectionsConnections,
+	})
+}
+
+func testSweepCodeStarConnectionsConnections(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).codestarconnectionsconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListConnectionsPages(&codestarconnections.ListConnectionsInput{}, func(page *codestarconnections.ListConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, connection := range page.Connections {
+			if connection == nil {
+				continue
+			}
+
+			arn := aws.StringValue(connection.ConnectionArn)
+			r := resourceAwsCodeStarConnectionsConnection()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			log.Printf("[INFO] Deleting CodeStar Connections Connection: %s", arn)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting CodeStar Connections Connection (%s): %w", arn, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping CodeStar Connections Connection sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing CodeStar Connections Connections: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSCodeStarConnectionsConnection_basic(t *testing.T) {
+	var v codestarconnections.Connection
+	resourceName := "aws_codestarconnections_connection.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(codestarconnections.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeStarConnectionsConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeStarConnectionsConnectionConfigProviderType(rName, codestarconnections.ProviderTypeBitbucket),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeStarConnectionsConnectionExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "codestar-connections", regexp.MustCompile("connection/.+")),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "connection_status", codestarconnections.ConnectionStatusPending),
+					resource.TestCheckResourceAttr(resourceName, "host_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "provider_type", codestarconnections.ProviderTypeBitbucket),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCodeStarConnectionsConnection_HostArn(t *testing.T) {
+	var v codestarconnections.Connection
+	resourceName := "aws_codestarconnections_connection.test"
+	hostResourceName := "aws_codestarconnections_host.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(codestarconnections.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeStarConnectionsConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeStarConnectionsConnectionConfigHostArn(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeStarConnectionsConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "host_arn", hostResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "provider_type", codestarconnections.ProviderTypeGitHubEnterpriseServer),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCodeStarConnectionsConnection_disappears(t *testing.T) {
+	var v codestarconnections.Connection
+	resourceName := "aws_codestarconnections_connection.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(codestarconnections.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeStarConnectionsConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeStarConnectionsConnectionConfigProviderType(rName, codestarconnections.ProviderTypeBitbucket),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeStarConnectionsConnectionExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCodeStarConnectionsConnection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCodeStarConnectionsConnection_Tags(t *testing.T) {
+	var v codestarconnections.Connection
+	resourceName := "aws_codestarconnections_connection.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(codestarconnections.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeStarConnectionsConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeStarConnectionsConnectionConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeStarConnectionsConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCodeStarConnectionsConnectionConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeStarConnectionsConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSCodeStarConnectionsConnectionConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeStarConnectionsConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCodeStarConnectionsConnectionExists(resourceName string, v *codestarconnections.Connection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CodeStar Connections Connection ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).codestarconnectionsconn
+
+		connection, err := finder.ConnectionByArn(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if connection == nil {
+			return fmt.Errorf("CodeStar Connections Connection (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *connection
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCodeStarConnectionsConnectionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).codestarconnectionsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_codestarconnections_connection" {
+			continue
+		}
+
+		connection, err := finder.ConnectionByArn(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, codestarconnections.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if connection == nil {
+			continue
+		}
+
+		return fmt.Errorf("CodeStar Connections Connection (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCodeStarConnectionsConnectionConfigProviderType(rName, providerType string) string {
+	return fmt.Sprintf(`
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = %[2]q
+}
+`, rName, providerType)
+}
+
+func testAccAWSCodeStarConnectionsConnectionConfigHostArn(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codestarconnections_host" "test" {
+  name              = %[1]q
+  provider_endpoint = "https://test.com"
+  provider_type     = "GitHubEnterpriseServer"
+}
+
+resource "aws_codestarconnections_connection" "test" {
+  name     = %[1]q
+  host_arn = aws_codestarconnections_host.test.arn
+}
+`, rName)
+}
+
+func testAccAWSCodeStarConnectionsConnectionConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "Bitbucket"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSCodeStarConnectionsConnectionConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "Bitbucket"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/codestarconnections/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/codestarconnections/waiter"
+)
+
+func resourceAwsCodeStarConnectionsHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCodeStarConnectionsHostCreate,
+		Read:   resourceAwsCodeStarConnectionsHostRead,
+		Delete: resourceAwsCodeStarConnectionsHostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"provider_endpoint": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"provider_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(codestarconnections.ProviderType_Values(), false),
+			},
+			"vpc_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"tls_certificate": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 16384),
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCodeStarConnectionsHostCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codestarconnectionsconn
+
+	name := d.Get("name").(string)
+	input := &codestarconnections.CreateHostInput{
+		Name:             aws.String(name),
+		ProviderEndpoint: aws.String(d.Get("provider_endpoint").(string)),
+		ProviderType:     aws.String(d.Get("provider_type").(string)),
+	}
+
+	if v, ok := d.GetOk("vpc_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VpcConfiguration = expandCodeStarConnectionsHostVpcConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating CodeStar Connections Host: %s", input)
+	output, err := conn.CreateHost(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CodeStar Connections Host (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.HostArn))
+
+	if _, err := waiter.HostPendingOrAvailable(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for CodeStar Connections Host (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsCodeStarConnectionsHostRead(d, meta)
+}
+
+func resourceAwsCodeStarConnectionsHostRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codestarconnectionsconn
+
+	output, err := finder.HostByArn(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, codestarconnections.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] CodeStar Connections Host (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CodeStar Connections Host (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading CodeStar Connections Host (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] CodeStar Connections Host (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", d.Id())
+	d.Set("connection_status", output.Status)
+	d.Set("name", output.Name)
+	d.Set("provider_endpoint", output.ProviderEndpoint)
+	d.Set("provider_type", output.ProviderType)
+
+	if err := d.Set("vpc_configuration", flattenCodeStarConnectionsHostVpcConfiguration(output.VpcConfiguration)); err != nil {
+		return fmt.Errorf("error setting vpc_configuration: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCodeStarConnectionsHostDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).codestarconnectionsconn
+
+	log.Printf("[DEBUG] Deleting CodeStar Connections Host: %s", d.Id())
+	_, err := conn.DeleteHost(&codestarconnections.DeleteHostInput{
+		HostArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, codestarconnections.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CodeStar Connections Host (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.HostDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for CodeStar Connections Host (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCodeStarConnectionsHostVpcConfiguration(tfMap map[string]interface{}) *codestarconnections.VpcConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &codestarconnections.VpcConfiguration{}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["tls_certificate"].(string); ok && v != "" {
+		apiObject.TlsCertificate = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_id"].(string); ok && v != "" {
+		apiObject.VpcId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenCodeStarConnectionsHostVpcConfiguration(apiObject *codestarconnections.VpcConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"security_group_ids": flattenStringSet(apiObject.SecurityGroupIds),
+		"subnet_ids":         flattenStringSet(apiObject.SubnetIds),
+		"tls_certificate":    aws.StringValue(apiObject.TlsCertificate),
+		"vpc_id":             aws.StringValue(apiObject.VpcId),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,274 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/codestarconnections/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_codestarconnections_host", &resource.Sweeper{
+		Name: "aws_codestarconnections_host",
+		F:    testSweepCodeStarConnectionsHosts,
+		Dependencies: []string{
+			"aws_codestarconnections_connection",
+		},
+	})
+}
+
+func testSweepCodeStarConnectionsHosts(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).codestarconnectionsconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListHostsPages(&codestarconnections.ListHostsInput{}, func(page *codestarconnections.ListHostsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, host := range page.Hosts {
+			if host == nil {
+				continue
+			}
+
+			arn := aws.StringValue(host.HostArn)
+			r := resourceAwsCodeStarConnectionsHost()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			log.Printf("[INFO] Deleting CodeStar Connections Host: %s", arn)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting CodeStar Connections Host (%s): %w", arn, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping CodeStar Connections Host sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing CodeStar Connections Hosts: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSCodeStarConnectionsHost_basic(t *testing.T) {
+	var v codestarconnections.GetHostOutput
+	resourceName := "aws_codestarconnections_host.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(codestarconnections.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeStarConnectionsHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeStarConnectionsHostConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeStarConnectionsHostExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "codestar-connections", regexp.MustCompile("host/.+")),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "connection_status", "PENDING"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "provider_endpoint", "https://test.com"),
+					resource.TestCheckResourceAttr(resourceName, "provider_type", codestarconnections.ProviderTypeGitHubEnterpriseServer),
+					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCodeStarConnectionsHost_disappears(t *testing.T) {
+	var v codestarconnections.GetHostOutput
+	resourceName := "aws_codestarconnections_host.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(codestarconnections.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeStarConnectionsHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeStarConnectionsHostConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeStarConnectionsHostExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCodeStarConnectionsHost(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCodeStarConnectionsHost_VpcConfiguration(t *testing.T) {
+	var v codestarconnections.GetHostOutput
+	resourceName := "aws_codestarconnections_host.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(codestarconnections.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCodeStarConnectionsHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCodeStarConnectionsHostConfigVpcConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCodeStarConnectionsHostExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.0.security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.0.subnet_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_configuration.0.vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCodeStarConnectionsHostExists(resourceName string, v *codestarconnections.GetHostOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CodeStar Connections Host ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).codestarconnectionsconn
+
+		output, err := finder.HostByArn(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("CodeStar Connections Host (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCodeStarConnectionsHostDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).codestarconnectionsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_codestarconnections_host" {
+			continue
+		}
+
+		output, err := finder.HostByArn(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, codestarconnections.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			continue
+		}
+
+		return fmt.Errorf("CodeStar Connections Host (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSCodeStarConnectionsHostConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codestarconnections_host" "test" {
+  name              = %[1]q
+  provider_endpoint = "https://test.com"
+  provider_type     = "GitHubEnterpriseServer"
+}
+`, rName)
+}
+
+func testAccAWSCodeStarConnectionsHostConfigVpcConfiguration(rName string) string {
+	return composeConfig(
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_codestarconnections_host" "test" {
+  name              = %[1]q
+  provider_endpoint = "https://test.com"
+  provider_type     = "GitHubEnterpriseServer"
+
+  vpc_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.test[*].id
+    vpc_id             = aws_vpc.test.id
+  }
+}
+`, rName))
+}
@@ -24,6 +24,8 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"default_route_table_id": {
 				Type:     schema.TypeString,
@@ -107,7 +109,8 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 				Set: resourceAwsRouteTableHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"owner_id": {
 				Type:     schema.TypeString,
@@ -140,8 +143,11 @@ func resourceAwsDefaultRouteTableCreate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
-		if err := keyvaluetags.Ec2CreateTags(conn, d.Id(), v); err != nil {
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		if err := keyvaluetags.Ec2CreateTags(conn, d.Id(), tags.IgnoreAws().Map()); err != nil {
 			return fmt.Errorf("error adding tags: %s", err)
 		}
 	}
@@ -0,0 +1,353 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+)
+
+func resourceAwsIotAnalyticsChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsChannelCreate,
+		Read:   resourceAwsIotAnalyticsChannelRead,
+		Update: resourceAwsIotAnalyticsChannelUpdate,
+		Delete: resourceAwsIotAnalyticsChannelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIotAnalyticsName,
+			},
+			"retention_period": iotAnalyticsRetentionPeriodSchema(),
+			"storage": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed_s3": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"storage.0.customer_managed_s3", "storage.0.service_managed_s3"},
+							Elem:         iotAnalyticsCustomerManagedS3StorageResource(),
+						},
+						"service_managed_s3": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"storage.0.customer_managed_s3", "storage.0.service_managed_s3"},
+							Elem: &schema.Resource{
+								// No options currently; just existence of "service_managed_s3".
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+var validateIotAnalyticsName = validation.All(
+	validation.StringLenBetween(1, 128),
+	validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_]+$`), "must contain only alphanumeric characters and underscores"),
+)
+
+func iotAnalyticsRetentionPeriodSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"number_of_days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"unlimited": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func iotAnalyticsCustomerManagedS3StorageResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(3, 255),
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`/$`), "must end with a slash"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateChannelInput{
+		ChannelName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionPeriod = expandIotAnalyticsRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ChannelStorage = expandIotAnalyticsChannelStorage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Channel: %s", input)
+	_, err := conn.CreateChannel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Channel (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsChannelRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	channel, err := finder.ChannelByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] IoT Analytics Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Channel (%s): %w", d.Id(), err)
+	}
+
+	if channel == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading IoT Analytics Channel (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] IoT Analytics Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(channel.Arn)
+	d.Set("arn", arn)
+	d.Set("name", channel.Name)
+
+	if err := d.Set("retention_period", flattenIotAnalyticsRetentionPeriod(channel.RetentionPeriod)); err != nil {
+		return fmt.Errorf("error setting retention_period: %w", err)
+	}
+
+	if err := d.Set("storage", flattenIotAnalyticsChannelStorage(channel.Storage)); err != nil {
+		return fmt.Errorf("error setting storage: %w", err)
+	}
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Channel (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChanges("retention_period", "storage") {
+		input := &iotanalytics.UpdateChannelInput{
+			ChannelName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionPeriod = expandIotAnalyticsRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ChannelStorage = expandIotAnalyticsChannelStorage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Channel: %s", input)
+		_, err := conn.UpdateChannel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Channel (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Channel (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsChannelRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Channel: %s", d.Id())
+	_, err := conn.DeleteChannel(&iotanalytics.DeleteChannelInput{
+		ChannelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Channel (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotAnalyticsRetentionPeriod(tfMap map[string]interface{}) *iotanalytics.RetentionPeriod {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.RetentionPeriod{}
+
+	if v, ok := tfMap["number_of_days"].(int); ok && v != 0 {
+		apiObject.NumberOfDays = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["unlimited"].(bool); ok && v {
+		apiObject.Unlimited = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandIotAnalyticsChannelStorage(tfMap map[string]interface{}) *iotanalytics.ChannelStorage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.ChannelStorage{}
+
+	if v, ok := tfMap["customer_managed_s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CustomerManagedS3 = &iotanalytics.CustomerManagedChannelS3Storage{
+			Bucket:  aws.String(tfMap["bucket"].(string)),
+			RoleArn: aws.String(tfMap["role_arn"].(string)),
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			apiObject.CustomerManagedS3.KeyPrefix = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["service_managed_s3"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ServiceManagedS3 = &iotanalytics.ServiceManagedChannelS3Storage{}
+	}
+
+	return apiObject
+}
+
+func flattenIotAnalyticsRetentionPeriod(apiObject *iotanalytics.RetentionPeriod) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"number_of_days": aws.Int64Value(apiObject.NumberOfDays),
+			"unlimited":      aws.BoolValue(apiObject.Unlimited),
+		},
+	}
+}
+
+func flattenIotAnalyticsChannelStorage(apiObject *iotanalytics.ChannelStorage) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomerManagedS3; v != nil {
+		tfMap["customer_managed_s3"] = []interface{}{
+			map[string]interface{}{
+				"bucket":     aws.StringValue(v.Bucket),
+				"key_prefix": aws.StringValue(v.KeyPrefix),
+				"role_arn":   aws.StringValue(v.RoleArn),
+			},
+		}
+	}
+
+	if apiObject.ServiceManagedS3 != nil {
+		tfMap["service_managed_s3"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return []interface{}{tfMap}
+}
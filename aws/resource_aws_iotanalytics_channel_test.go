@@ -0,0 +1,421 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotanalytics_channel", &resource.Sweeper{
+		Name: "aws_iotanalytics_channel",
+		F:    testSweepIotAnalyticsChannels,
+		Dependencies: []string{
+			"aws_iotanalytics_pipeline",
+		},
+	})
+}
+
+func testSweepIotAnalyticsChannels(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).iotanalyticsconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListChannelsPages(&iotanalytics.ListChannelsInput{}, func(page *iotanalytics.ListChannelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, channel := range page.ChannelSummaries {
+			if channel == nil {
+				continue
+			}
+
+			name := aws.StringValue(channel.ChannelName)
+			r := resourceAwsIotAnalyticsChannel()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			log.Printf("[INFO] Deleting IoT Analytics Channel: %s", name)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting IoT Analytics Channel (%s): %w", name, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Channel sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing IoT Analytics Channels: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+// testAccIotAnalyticsResourceName returns a random name that satisfies IoT Analytics naming rules.
+func testAccIotAnalyticsResourceName() string {
+	return strings.ReplaceAll(acctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+}
+
+func TestAccAWSIotAnalyticsChannel_basic(t *testing.T) {
+	var v iotanalytics.Channel
+	resourceName := "aws_iotanalytics_channel.test"
+	rName := testAccIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsChannelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", regexp.MustCompile(fmt.Sprintf("channel/%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.service_managed_s3.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsChannel_disappears(t *testing.T) {
+	var v iotanalytics.Channel
+	resourceName := "aws_iotanalytics_channel.test"
+	rName := testAccIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsChannelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotAnalyticsChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsChannel_tags(t *testing.T) {
+	var v iotanalytics.Channel
+	resourceName := "aws_iotanalytics_channel.test"
+	rName := testAccIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsChannelConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsChannelConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotAnalyticsChannelConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsChannel_RetentionPeriod(t *testing.T) {
+	var v iotanalytics.Channel
+	resourceName := "aws_iotanalytics_channel.test"
+	rName := testAccIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsChannelConfigRetentionPeriod(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsChannelConfigRetentionPeriod(rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "60"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsChannel_StorageCustomerManagedS3(t *testing.T) {
+	var v iotanalytics.Channel
+	resourceName := "aws_iotanalytics_channel.test"
+	rName := testAccIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsChannelConfigStorageCustomerManagedS3(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage.0.customer_managed_s3.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.customer_managed_s3.0.key_prefix", "prefix/"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage.0.customer_managed_s3.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "storage.0.service_managed_s3.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotAnalyticsChannelExists(resourceName string, v *iotanalytics.Channel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Channel ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+		output, err := finder.ChannelByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("IoT Analytics Channel (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotAnalyticsChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotanalytics_channel" {
+			continue
+		}
+
+		output, err := finder.ChannelByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			continue
+		}
+
+		return fmt.Errorf("IoT Analytics Channel (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccAWSIotAnalyticsConfigS3StorageBase returns configuration for an S3 bucket
+// and an IAM role that IoT Analytics can use for customer-managed storage.
+func testAccAWSIotAnalyticsConfigS3StorageBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = replace(%[1]q, "_", "-")
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "iotanalytics.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:GetBucketLocation",
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:PutObject",
+        "s3:DeleteObject",
+      ]
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccAWSIotAnalyticsChannelConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSIotAnalyticsChannelConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSIotAnalyticsChannelConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAWSIotAnalyticsChannelConfigRetentionPeriod(rName string, numberOfDays int) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  retention_period {
+    number_of_days = %[2]d
+  }
+}
+`, rName, numberOfDays)
+}
+
+func testAccAWSIotAnalyticsChannelConfigStorageCustomerManagedS3(rName string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsConfigS3StorageBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  storage {
+    customer_managed_s3 {
+      bucket     = aws_s3_bucket.test.bucket
+      key_prefix = "prefix/"
+      role_arn   = aws_iam_role.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
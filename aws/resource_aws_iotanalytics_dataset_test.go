@@ -0,0 +1,391 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotanalytics_dataset", &resource.Sweeper{
+		Name: "aws_iotanalytics_dataset",
+		F:    testSweepIotAnalyticsDatasets,
+	})
+}
+
+func testSweepIotAnalyticsDatasets(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).iotanalyticsconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListDatasetsPages(&iotanalytics.ListDatasetsInput{}, func(page *iotanalytics.ListDatasetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, dataset := range page.DatasetSummaries {
+			if dataset == nil {
+				continue
+			}
+
+			name := aws.StringValue(dataset.DatasetName)
+			r := resourceAwsIotAnalyticsDataset()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			log.Printf("[INFO] Deleting IoT Analytics Dataset: %s", name)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting IoT Analytics Dataset (%s): %w", name, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Dataset sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing IoT Analytics Datasets: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSIotAnalyticsDataset_basic(t *testing.T) {
+	var v iotanalytics.Dataset
+	resourceName := "aws_iotanalytics_dataset.test"
+	rName := testAccIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsDatasetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsDatasetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatasetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.container_action.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.name", "query_action"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.sql_query", fmt.Sprintf("SELECT * FROM %s", rName)),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", regexp.MustCompile(fmt.Sprintf("dataset/%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsDataset_disappears(t *testing.T) {
+	var v iotanalytics.Dataset
+	resourceName := "aws_iotanalytics_dataset.test"
+	rName := testAccIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsDatasetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsDatasetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatasetExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotAnalyticsDataset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsDataset_tags(t *testing.T) {
+	var v iotanalytics.Dataset
+	resourceName := "aws_iotanalytics_dataset.test"
+	rName := testAccIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsDatasetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsDatasetConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatasetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotAnalyticsDatasetConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatasetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotAnalyticsDatasetConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatasetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsDataset_TriggerAndDelivery(t *testing.T) {
+	var v iotanalytics.Dataset
+	resourceName := "aws_iotanalytics_dataset.test"
+	rName := testAccIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsDatasetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsDatasetConfigTriggerAndDelivery(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsDatasetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.0.delta_time.0.offset_seconds", "-60"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.0.delta_time.0.time_expression", "from_unixtime(timestamp)"),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.0.destination.0.s3_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "content_delivery_rule.0.destination.0.s3_destination.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.0.destination.0.s3_destination.0.key", "dataset/!{iotanalytics:scheduleTime}/!{iotanalytics:versionId}.csv"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.schedule.0.expression", "rate(1 hour)"),
+					resource.TestCheckResourceAttr(resourceName, "versioning_configuration.0.max_versions", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotAnalyticsDatasetExists(resourceName string, v *iotanalytics.Dataset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Dataset ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+		output, err := finder.DatasetByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("IoT Analytics Dataset (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotAnalyticsDatasetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotanalytics_dataset" {
+			continue
+		}
+
+		output, err := finder.DatasetByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			continue
+		}
+
+		return fmt.Errorf("IoT Analytics Dataset (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotAnalyticsDatasetConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSIotAnalyticsDatasetConfig(rName string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsDatasetConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query_action"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSIotAnalyticsDatasetConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsDatasetConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query_action"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSIotAnalyticsDatasetConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsDatasetConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query_action"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccAWSIotAnalyticsDatasetConfigTriggerAndDelivery(rName string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsDatasetConfigBase(rName),
+		testAccAWSIotAnalyticsConfigS3StorageBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    name = "query_action"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+
+      filter {
+        delta_time {
+          offset_seconds  = -60
+          time_expression = "from_unixtime(timestamp)"
+        }
+      }
+    }
+  }
+
+  content_delivery_rule {
+    destination {
+      s3_destination {
+        bucket   = aws_s3_bucket.test.bucket
+        key      = "dataset/!{iotanalytics:scheduleTime}/!{iotanalytics:versionId}.csv"
+        role_arn = aws_iam_role.test.arn
+      }
+    }
+  }
+
+  retention_period {
+    number_of_days = 7
+  }
+
+  trigger {
+    schedule {
+      expression = "rate(1 hour)"
+    }
+  }
+
+  versioning_configuration {
+    max_versions = 5
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
@@ -0,0 +1,268 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+)
+
+func resourceAwsIotAnalyticsDatastore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsDatastoreCreate,
+		Read:   resourceAwsIotAnalyticsDatastoreRead,
+		Update: resourceAwsIotAnalyticsDatastoreUpdate,
+		Delete: resourceAwsIotAnalyticsDatastoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIotAnalyticsName,
+			},
+			"retention_period": iotAnalyticsRetentionPeriodSchema(),
+			"storage": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed_s3": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"storage.0.customer_managed_s3", "storage.0.service_managed_s3"},
+							Elem:         iotAnalyticsCustomerManagedS3StorageResource(),
+						},
+						"service_managed_s3": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"storage.0.customer_managed_s3", "storage.0.service_managed_s3"},
+							Elem: &schema.Resource{
+								// No options currently; just existence of "service_managed_s3".
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsDatastoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateDatastoreInput{
+		DatastoreName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionPeriod = expandIotAnalyticsRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DatastoreStorage = expandIotAnalyticsDatastoreStorage(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Datastore: %s", input)
+	_, err := conn.CreateDatastore(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Datastore (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsDatastoreRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatastoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	datastore, err := finder.DatastoreByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] IoT Analytics Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Datastore (%s): %w", d.Id(), err)
+	}
+
+	if datastore == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading IoT Analytics Datastore (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] IoT Analytics Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(datastore.Arn)
+	d.Set("arn", arn)
+	d.Set("name", datastore.Name)
+
+	if err := d.Set("retention_period", flattenIotAnalyticsRetentionPeriod(datastore.RetentionPeriod)); err != nil {
+		return fmt.Errorf("error setting retention_period: %w", err)
+	}
+
+	if err := d.Set("storage", flattenIotAnalyticsDatastoreStorage(datastore.Storage)); err != nil {
+		return fmt.Errorf("error setting storage: %w", err)
+	}
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Datastore (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsDatastoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChanges("retention_period", "storage") {
+		input := &iotanalytics.UpdateDatastoreInput{
+			DatastoreName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("retention_period"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionPeriod = expandIotAnalyticsRetentionPeriod(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DatastoreStorage = expandIotAnalyticsDatastoreStorage(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Datastore: %s", input)
+		_, err := conn.UpdateDatastore(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Datastore (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Datastore (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsDatastoreRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatastoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Datastore: %s", d.Id())
+	_, err := conn.DeleteDatastore(&iotanalytics.DeleteDatastoreInput{
+		DatastoreName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Datastore (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotAnalyticsDatastoreStorage(tfMap map[string]interface{}) *iotanalytics.DatastoreStorage {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotanalytics.DatastoreStorage{}
+
+	if v, ok := tfMap["customer_managed_s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CustomerManagedS3 = &iotanalytics.CustomerManagedDatastoreS3Storage{
+			Bucket:  aws.String(tfMap["bucket"].(string)),
+			RoleArn: aws.String(tfMap["role_arn"].(string)),
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			apiObject.CustomerManagedS3.KeyPrefix = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["service_managed_s3"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ServiceManagedS3 = &iotanalytics.ServiceManagedDatastoreS3Storage{}
+	}
+
+	return apiObject
+}
+
+func flattenIotAnalyticsDatastoreStorage(apiObject *iotanalytics.DatastoreStorage) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomerManagedS3; v != nil {
+		tfMap["customer_managed_s3"] = []interface{}{
+			map[string]interface{}{
+				"bucket":     aws.StringValue(v.Bucket),
+				"key_prefix": aws.StringValue(v.KeyPrefix),
+				"role_arn":   aws.StringValue(v.RoleArn),
+			},
+		}
+	}
+
+	if apiObject.ServiceManagedS3 != nil {
+		tfMap["service_managed_s3"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return []interface{}{tfMap}
+}
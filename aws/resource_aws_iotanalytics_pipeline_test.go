@@ -0,0 +1,464 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotanalytics_pipeline", &resource.Sweeper{
+		Name: "aws_iotanalytics_pipeline",
+		F:    testSweepIotAnalyticsPipelines,
+	})
+}
+
+func testSweepIotAnalyticsPipelines(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).iotanalyticsconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListPipelinesPages(&iotanalytics.ListPipelinesInput{}, func(page *iotanalytics.ListPipelinesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, pipeline := range page.PipelineSummaries {
+			if pipeline == nil {
+				continue
+			}
+
+			name := aws.StringValue(pipeline.PipelineName)
+			r := resourceAwsIotAnalyticsPipeline()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			log.Printf("[INFO] Deleting IoT Analytics Pipeline: %s", name)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting IoT Analytics Pipeline (%s): %w", name, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Analytics Pipeline sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing IoT Analytics Pipelines: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSIotAnalyticsPipeline_basic(t *testing.T) {
+	var v iotanalytics.Pipeline
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := testAccIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.0.channel.0.channel_name", "aws_iotanalytics_channel.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.name", "channel_activity"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.next", "datastore_activity"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.1.datastore.0.datastore_name", "aws_iotanalytics_datastore.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.0.name", "datastore_activity"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", regexp.MustCompile(fmt.Sprintf("pipeline/%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "reprocess_on_update", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reprocess_on_update"},
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigActivities(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "5"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.next", "filter_activity"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.filter.0.filter", "temperature > 0"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.math.0.attribute", "fahrenheit"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.add_attributes.0.attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.add_attributes.0.attributes.temperature", "celsius"),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.datastore.0.name", "datastore_activity"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_disappears(t *testing.T) {
+	var v iotanalytics.Pipeline
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := testAccIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotAnalyticsPipeline(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_tags(t *testing.T) {
+	var v iotanalytics.Pipeline
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := testAccIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reprocess_on_update"},
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_ReprocessOnUpdate(t *testing.T) {
+	var v iotanalytics.Pipeline
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := testAccIotAnalyticsResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotanalytics.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigReprocessOnUpdate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "reprocess_on_update", "true"),
+				),
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigActivities(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					testAccCheckAWSIotAnalyticsPipelineReprocessingStarted(&v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotAnalyticsPipelineExists(resourceName string, v *iotanalytics.Pipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Pipeline ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+		output, err := finder.PipelineByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("IoT Analytics Pipeline (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotAnalyticsPipelineReprocessingStarted(v *iotanalytics.Pipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(v.ReprocessingSummaries) == 0 {
+			return fmt.Errorf("IoT Analytics Pipeline (%s) has no reprocessing summaries", aws.StringValue(v.Name))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotAnalyticsPipelineDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotanalytics_pipeline" {
+			continue
+		}
+
+		output, err := finder.PipelineByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			continue
+		}
+
+		return fmt.Errorf("IoT Analytics Pipeline (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotAnalyticsPipelineConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSIotAnalyticsPipelineConfig(rName string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsPipelineConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "channel_activity"
+      channel_name = aws_iotanalytics_channel.test.name
+      next         = "datastore_activity"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore_activity"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSIotAnalyticsPipelineConfigActivities(rName string, reprocessOnUpdate bool) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsPipelineConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name                = %[1]q
+  reprocess_on_update = %[2]t
+
+  activity {
+    channel {
+      name         = "channel_activity"
+      channel_name = aws_iotanalytics_channel.test.name
+      next         = "filter_activity"
+    }
+  }
+
+  activity {
+    filter {
+      name   = "filter_activity"
+      filter = "temperature > 0"
+      next   = "math_activity"
+    }
+  }
+
+  activity {
+    math {
+      name      = "math_activity"
+      attribute = "fahrenheit"
+      math      = "temperature * 1.8 + 32"
+      next      = "add_attributes_activity"
+    }
+  }
+
+  activity {
+    add_attributes {
+      name = "add_attributes_activity"
+      next = "datastore_activity"
+
+      attributes = {
+        temperature = "celsius"
+      }
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore_activity"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+}
+`, rName, reprocessOnUpdate))
+}
+
+func testAccAWSIotAnalyticsPipelineConfigReprocessOnUpdate(rName string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsPipelineConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name                = %[1]q
+  reprocess_on_update = true
+
+  activity {
+    channel {
+      name         = "channel_activity"
+      channel_name = aws_iotanalytics_channel.test.name
+      next         = "datastore_activity"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore_activity"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSIotAnalyticsPipelineConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsPipelineConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "channel_activity"
+      channel_name = aws_iotanalytics_channel.test.name
+      next         = "datastore_activity"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore_activity"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSIotAnalyticsPipelineConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsPipelineConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      name         = "channel_activity"
+      channel_name = aws_iotanalytics_channel.test.name
+      next         = "datastore_activity"
+    }
+  }
+
+  activity {
+    datastore {
+      name           = "datastore_activity"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,1403 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/waiter"
+)
+
+func resourceAwsIotEventsDetectorModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotEventsDetectorModelCreate,
+		Read:   resourceAwsIotEventsDetectorModelRead,
+		Update: resourceAwsIotEventsDetectorModelUpdate,
+		Delete: resourceAwsIotEventsDetectorModelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			// The JSON representation of a structured definition is only known after apply.
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				if diff.HasChange("definition") && len(diff.Get("definition").([]interface{})) > 0 {
+					return diff.SetNewComputed("definition_json")
+				}
+
+				return nil
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"definition", "definition_json"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"initial_state_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"state": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"on_enter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": iotEventsDetectorModelEventSchema(false),
+											},
+										},
+									},
+									"on_exit": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": iotEventsDetectorModelEventSchema(false),
+											},
+										},
+									},
+									"on_input": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event":            iotEventsDetectorModelEventSchema(false),
+												"transition_event": iotEventsDetectorModelEventSchema(true),
+											},
+										},
+									},
+									"state_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"definition_json": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.All(
+					validation.StringIsJSON,
+					validateIotEventsDetectorModelDefinitionJson,
+				),
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"evaluation_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(iotevents.EvaluationMethod_Values(), false),
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func iotEventsDetectorModelEventSchema(transition bool) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"action": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"clear_timer": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"timer_name": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringLenBetween(1, 128),
+								},
+							},
+						},
+					},
+					"dynamodb": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"hash_key_field": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"hash_key_type": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice([]string{"NUMBER", "STRING"}, false),
+								},
+								"hash_key_value": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"operation": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice([]string{"DELETE", "INSERT", "UPDATE"}, false),
+								},
+								"payload": iotEventsDetectorModelPayloadSchema(),
+								"payload_field": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"range_key_field": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"range_key_type": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice([]string{"NUMBER", "STRING"}, false),
+								},
+								"range_key_value": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"table_name": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+					"dynamodbv2": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"payload": iotEventsDetectorModelPayloadSchema(),
+								"table_name": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+					"firehose": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"delivery_stream_name": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"payload": iotEventsDetectorModelPayloadSchema(),
+								"separator": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([\n\t])|(\r\n)|(,)$`), "must be one of newline, tab, Windows newline or comma"),
+								},
+							},
+						},
+					},
+					"iot_events": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"input_name": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringLenBetween(1, 128),
+								},
+								"payload": iotEventsDetectorModelPayloadSchema(),
+							},
+						},
+					},
+					"iot_site_wise": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"asset_id": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"entry_id": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"property_alias": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"property_id": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"property_value": {
+									Type:     schema.TypeList,
+									Required: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"quality": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"timestamp": {
+												Type:     schema.TypeList,
+												Optional: true,
+												MaxItems: 1,
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"offset_in_nanos": {
+															Type:     schema.TypeString,
+															Optional: true,
+														},
+														"time_in_seconds": {
+															Type:     schema.TypeString,
+															Required: true,
+														},
+													},
+												},
+											},
+											"value": {
+												Type:     schema.TypeList,
+												Required: true,
+												MaxItems: 1,
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"boolean_value": {
+															Type:     schema.TypeString,
+															Optional: true,
+														},
+														"double_value": {
+															Type:     schema.TypeString,
+															Optional: true,
+														},
+														"integer_value": {
+															Type:     schema.TypeString,
+															Optional: true,
+														},
+														"string_value": {
+															Type:     schema.TypeString,
+															Optional: true,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"iot_topic_publish": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"mqtt_topic": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringLenBetween(1, 128),
+								},
+								"payload": iotEventsDetectorModelPayloadSchema(),
+							},
+						},
+					},
+					"lambda": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"function_arn": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validateArn,
+								},
+								"payload": iotEventsDetectorModelPayloadSchema(),
+							},
+						},
+					},
+					"reset_timer": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"timer_name": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringLenBetween(1, 128),
+								},
+							},
+						},
+					},
+					"set_timer": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"duration_expression": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringLenBetween(1, 1024),
+								},
+								"seconds": {
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntBetween(1, 31622400),
+								},
+								"timer_name": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringLenBetween(1, 128),
+								},
+							},
+						},
+					},
+					"set_variable": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"value": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringLenBetween(1, 1024),
+								},
+								"variable_name": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringLenBetween(1, 128),
+								},
+							},
+						},
+					},
+					"sns": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"payload": iotEventsDetectorModelPayloadSchema(),
+								"target_arn": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validateArn,
+								},
+							},
+						},
+					},
+					"sqs": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"payload": iotEventsDetectorModelPayloadSchema(),
+								"queue_url": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"use_base64": {
+									Type:     schema.TypeBool,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"condition": {
+			Type:         schema.TypeString,
+			Optional:     !transition,
+			Required:     transition,
+			ValidateFunc: validation.StringLenBetween(0, 512),
+		},
+		"event_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(0, 128),
+		},
+	}
+
+	if transition {
+		s["next_state"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func iotEventsDetectorModelPayloadSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_expression": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(iotevents.PayloadType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsIotEventsDetectorModelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	definition, err := expandIotEventsDetectorModelDefinitionFromResourceData(d)
+
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	input := &iotevents.CreateDetectorModelInput{
+		DetectorModelDefinition: definition,
+		DetectorModelName:       aws.String(name),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.DetectorModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("evaluation_method"); ok {
+		input.EvaluationMethod = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key"); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().IoteventsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Events Detector Model: %s", input)
+	_, err = conn.CreateDetectorModel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Events Detector Model (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.DetectorModelActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Events Detector Model (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsIotEventsDetectorModelRead(d, meta)
+}
+
+func resourceAwsIotEventsDetectorModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	detectorModel, err := finder.DetectorModelByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] IoT Events Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Events Detector Model (%s): %w", d.Id(), err)
+	}
+
+	if detectorModel == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading IoT Events Detector Model (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] IoT Events Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	configuration := detectorModel.DetectorModelConfiguration
+	arn := aws.StringValue(configuration.DetectorModelArn)
+	d.Set("arn", arn)
+
+	// Only populate the structured definition if it is in use; otherwise only the JSON representation is set.
+	if v, ok := d.GetOk("definition"); ok && len(v.([]interface{})) > 0 {
+		if err := d.Set("definition", flattenIotEventsDetectorModelDefinition(detectorModel.DetectorModelDefinition)); err != nil {
+			return fmt.Errorf("error setting definition: %w", err)
+		}
+	}
+
+	definitionJson, err := jsonutil.BuildJSON(detectorModel.DetectorModelDefinition)
+
+	if err != nil {
+		return fmt.Errorf("error serializing IoT Events Detector Model (%s) definition: %w", d.Id(), err)
+	}
+
+	d.Set("definition_json", string(definitionJson))
+	d.Set("description", configuration.DetectorModelDescription)
+	d.Set("evaluation_method", configuration.EvaluationMethod)
+	d.Set("key", configuration.Key)
+	d.Set("name", configuration.DetectorModelName)
+	d.Set("role_arn", configuration.RoleArn)
+	d.Set("status", configuration.Status)
+	d.Set("version", configuration.DetectorModelVersion)
+
+	tags, err := keyvaluetags.IoteventsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Events Detector Model (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotEventsDetectorModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	if d.HasChanges("definition", "definition_json", "description", "evaluation_method", "role_arn") {
+		definition, err := expandIotEventsDetectorModelDefinitionFromResourceData(d)
+
+		if err != nil {
+			return err
+		}
+
+		input := &iotevents.UpdateDetectorModelInput{
+			DetectorModelDefinition:  definition,
+			DetectorModelDescription: aws.String(d.Get("description").(string)),
+			DetectorModelName:        aws.String(d.Id()),
+			RoleArn:                  aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("evaluation_method"); ok {
+			input.EvaluationMethod = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating IoT Events Detector Model: %s", input)
+		_, err = conn.UpdateDetectorModel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Events Detector Model (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.DetectorModelActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for IoT Events Detector Model (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.IoteventsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Events Detector Model (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotEventsDetectorModelRead(d, meta)
+}
+
+func resourceAwsIotEventsDetectorModelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	log.Printf("[DEBUG] Deleting IoT Events Detector Model: %s", d.Id())
+	_, err := conn.DeleteDetectorModel(&iotevents.DeleteDetectorModelInput{
+		DetectorModelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Events Detector Model (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.DetectorModelDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Events Detector Model (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func validateIotEventsDetectorModelDefinitionJson(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := expandIotEventsDetectorModelDefinitionJson(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid detector model definition: %w", k, err))
+	}
+
+	return
+}
+
+func expandIotEventsDetectorModelDefinitionFromResourceData(d *schema.ResourceData) (*iotevents.DetectorModelDefinition, error) {
+	if v, ok := d.GetOk("definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return expandIotEventsDetectorModelDefinition(v.([]interface{})[0].(map[string]interface{})), nil
+	}
+
+	definition, err := expandIotEventsDetectorModelDefinitionJson(d.Get("definition_json").(string))
+
+	if err != nil {
+		return nil, fmt.Errorf("error decoding definition_json: %w", err)
+	}
+
+	return definition, nil
+}
+
+// expandIotEventsDetectorModelDefinitionJson decodes a detector model definition as
+// accepted by the IoT Events API, e.g. exported from the console or the AWS CLI.
+func expandIotEventsDetectorModelDefinitionJson(rawDefinition string) (*iotevents.DetectorModelDefinition, error) {
+	definition := &iotevents.DetectorModelDefinition{}
+
+	if err := json.Unmarshal([]byte(rawDefinition), definition); err != nil {
+		return nil, err
+	}
+
+	return definition, nil
+}
+
+func expandIotEventsDetectorModelDefinition(tfMap map[string]interface{}) *iotevents.DetectorModelDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.DetectorModelDefinition{}
+
+	if v, ok := tfMap["initial_state_name"].(string); ok && v != "" {
+		apiObject.InitialStateName = aws.String(v)
+	}
+
+	if v, ok := tfMap["state"].([]interface{}); ok && len(v) > 0 {
+		apiObject.States = expandIotEventsDetectorModelStates(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsDetectorModelStates(tfList []interface{}) []*iotevents.State {
+	var apiObjects []*iotevents.State
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.State{
+			StateName: aws.String(tfMap["state_name"].(string)),
+		}
+
+		if v, ok := tfMap["on_enter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.OnEnter = &iotevents.OnEnterLifecycle{
+				Events: expandIotEventsDetectorModelEvents(v[0].(map[string]interface{})["event"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["on_exit"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.OnExit = &iotevents.OnExitLifecycle{
+				Events: expandIotEventsDetectorModelEvents(v[0].(map[string]interface{})["event"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["on_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.OnInput = &iotevents.OnInputLifecycle{
+				Events:           expandIotEventsDetectorModelEvents(tfMap["event"].([]interface{})),
+				TransitionEvents: expandIotEventsDetectorModelTransitionEvents(tfMap["transition_event"].([]interface{})),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotEventsDetectorModelEvents(tfList []interface{}) []*iotevents.Event {
+	var apiObjects []*iotevents.Event
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.Event{
+			EventName: aws.String(tfMap["event_name"].(string)),
+		}
+
+		if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Actions = expandIotEventsDetectorModelActions(v)
+		}
+
+		if v, ok := tfMap["condition"].(string); ok && v != "" {
+			apiObject.Condition = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotEventsDetectorModelTransitionEvents(tfList []interface{}) []*iotevents.TransitionEvent {
+	var apiObjects []*iotevents.TransitionEvent
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.TransitionEvent{
+			Condition: aws.String(tfMap["condition"].(string)),
+			EventName: aws.String(tfMap["event_name"].(string)),
+			NextState: aws.String(tfMap["next_state"].(string)),
+		}
+
+		if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Actions = expandIotEventsDetectorModelActions(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotEventsDetectorModelActions(tfList []interface{}) []*iotevents.ActionData {
+	var apiObjects []*iotevents.ActionData
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.ActionData{}
+
+		if v, ok := tfMap["clear_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.ClearTimer = &iotevents.ClearTimerAction{
+				TimerName: aws.String(tfMap["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["dynamodb"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			action := &iotevents.DynamoDBAction{
+				HashKeyField: aws.String(tfMap["hash_key_field"].(string)),
+				HashKeyValue: aws.String(tfMap["hash_key_value"].(string)),
+				Payload:      expandIotEventsDetectorModelPayload(tfMap["payload"].([]interface{})),
+				TableName:    aws.String(tfMap["table_name"].(string)),
+			}
+
+			if v, ok := tfMap["hash_key_type"].(string); ok && v != "" {
+				action.HashKeyType = aws.String(v)
+			}
+
+			if v, ok := tfMap["operation"].(string); ok && v != "" {
+				action.Operation = aws.String(v)
+			}
+
+			if v, ok := tfMap["payload_field"].(string); ok && v != "" {
+				action.PayloadField = aws.String(v)
+			}
+
+			if v, ok := tfMap["range_key_field"].(string); ok && v != "" {
+				action.RangeKeyField = aws.String(v)
+			}
+
+			if v, ok := tfMap["range_key_type"].(string); ok && v != "" {
+				action.RangeKeyType = aws.String(v)
+			}
+
+			if v, ok := tfMap["range_key_value"].(string); ok && v != "" {
+				action.RangeKeyValue = aws.String(v)
+			}
+
+			apiObject.DynamoDB = action
+		}
+
+		if v, ok := tfMap["dynamodbv2"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.DynamoDBv2 = &iotevents.DynamoDBv2Action{
+				Payload:   expandIotEventsDetectorModelPayload(tfMap["payload"].([]interface{})),
+				TableName: aws.String(tfMap["table_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			action := &iotevents.FirehoseAction{
+				DeliveryStreamName: aws.String(tfMap["delivery_stream_name"].(string)),
+				Payload:            expandIotEventsDetectorModelPayload(tfMap["payload"].([]interface{})),
+			}
+
+			if v, ok := tfMap["separator"].(string); ok && v != "" {
+				action.Separator = aws.String(v)
+			}
+
+			apiObject.Firehose = action
+		}
+
+		if v, ok := tfMap["iot_events"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.IotEvents = &iotevents.Action{
+				InputName: aws.String(tfMap["input_name"].(string)),
+				Payload:   expandIotEventsDetectorModelPayload(tfMap["payload"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["iot_site_wise"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.IotSiteWise = expandIotEventsDetectorModelIotSiteWiseAction(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["iot_topic_publish"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.IotTopicPublish = &iotevents.IotTopicPublishAction{
+				MqttTopic: aws.String(tfMap["mqtt_topic"].(string)),
+				Payload:   expandIotEventsDetectorModelPayload(tfMap["payload"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["lambda"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Lambda = &iotevents.LambdaAction{
+				FunctionArn: aws.String(tfMap["function_arn"].(string)),
+				Payload:     expandIotEventsDetectorModelPayload(tfMap["payload"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["reset_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.ResetTimer = &iotevents.ResetTimerAction{
+				TimerName: aws.String(tfMap["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["set_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			action := &iotevents.SetTimerAction{
+				TimerName: aws.String(tfMap["timer_name"].(string)),
+			}
+
+			if v, ok := tfMap["duration_expression"].(string); ok && v != "" {
+				action.DurationExpression = aws.String(v)
+			}
+
+			if v, ok := tfMap["seconds"].(int); ok && v != 0 {
+				action.Seconds = aws.Int64(int64(v))
+			}
+
+			apiObject.SetTimer = action
+		}
+
+		if v, ok := tfMap["set_variable"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.SetVariable = &iotevents.SetVariableAction{
+				Value:        aws.String(tfMap["value"].(string)),
+				VariableName: aws.String(tfMap["variable_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["sns"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Sns = &iotevents.SNSTopicPublishAction{
+				Payload:   expandIotEventsDetectorModelPayload(tfMap["payload"].([]interface{})),
+				TargetArn: aws.String(tfMap["target_arn"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["sqs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.Sqs = &iotevents.SqsAction{
+				Payload:   expandIotEventsDetectorModelPayload(tfMap["payload"].([]interface{})),
+				QueueUrl:  aws.String(tfMap["queue_url"].(string)),
+				UseBase64: aws.Bool(tfMap["use_base64"].(bool)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotEventsDetectorModelIotSiteWiseAction(tfMap map[string]interface{}) *iotevents.IotSiteWiseAction {
+	apiObject := &iotevents.IotSiteWiseAction{}
+
+	if v, ok := tfMap["asset_id"].(string); ok && v != "" {
+		apiObject.AssetId = aws.String(v)
+	}
+
+	if v, ok := tfMap["entry_id"].(string); ok && v != "" {
+		apiObject.EntryId = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_alias"].(string); ok && v != "" {
+		apiObject.PropertyAlias = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_id"].(string); ok && v != "" {
+		apiObject.PropertyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		propertyValue := &iotevents.AssetPropertyValue{}
+
+		if v, ok := tfMap["quality"].(string); ok && v != "" {
+			propertyValue.Quality = aws.String(v)
+		}
+
+		if v, ok := tfMap["timestamp"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			propertyValue.Timestamp = &iotevents.AssetPropertyTimestamp{
+				TimeInSeconds: aws.String(tfMap["time_in_seconds"].(string)),
+			}
+
+			if v, ok := tfMap["offset_in_nanos"].(string); ok && v != "" {
+				propertyValue.Timestamp.OffsetInNanos = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			value := &iotevents.AssetPropertyVariant{}
+
+			if v, ok := tfMap["boolean_value"].(string); ok && v != "" {
+				value.BooleanValue = aws.String(v)
+			}
+
+			if v, ok := tfMap["double_value"].(string); ok && v != "" {
+				value.DoubleValue = aws.String(v)
+			}
+
+			if v, ok := tfMap["integer_value"].(string); ok && v != "" {
+				value.IntegerValue = aws.String(v)
+			}
+
+			if v, ok := tfMap["string_value"].(string); ok && v != "" {
+				value.StringValue = aws.String(v)
+			}
+
+			propertyValue.Value = value
+		}
+
+		apiObject.PropertyValue = propertyValue
+	}
+
+	return apiObject
+}
+
+func expandIotEventsDetectorModelPayload(tfList []interface{}) *iotevents.Payload {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &iotevents.Payload{
+		ContentExpression: aws.String(tfMap["content_expression"].(string)),
+		Type:              aws.String(tfMap["type"].(string)),
+	}
+}
+
+func flattenIotEventsDetectorModelDefinition(apiObject *iotevents.DetectorModelDefinition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var states []interface{}
+
+	for _, state := range apiObject.States {
+		if state == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"state_name": aws.StringValue(state.StateName),
+		}
+
+		if v := state.OnEnter; v != nil {
+			tfMap["on_enter"] = []interface{}{
+				map[string]interface{}{
+					"event": flattenIotEventsDetectorModelEvents(v.Events),
+				},
+			}
+		}
+
+		if v := state.OnExit; v != nil {
+			tfMap["on_exit"] = []interface{}{
+				map[string]interface{}{
+					"event": flattenIotEventsDetectorModelEvents(v.Events),
+				},
+			}
+		}
+
+		if v := state.OnInput; v != nil {
+			tfMap["on_input"] = []interface{}{
+				map[string]interface{}{
+					"event":            flattenIotEventsDetectorModelEvents(v.Events),
+					"transition_event": flattenIotEventsDetectorModelTransitionEvents(v.TransitionEvents),
+				},
+			}
+		}
+
+		states = append(states, tfMap)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"initial_state_name": aws.StringValue(apiObject.InitialStateName),
+			"state":              states,
+		},
+	}
+}
+
+func flattenIotEventsDetectorModelEvents(apiObjects []*iotevents.Event) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":     flattenIotEventsDetectorModelActions(apiObject.Actions),
+			"condition":  aws.StringValue(apiObject.Condition),
+			"event_name": aws.StringValue(apiObject.EventName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenIotEventsDetectorModelTransitionEvents(apiObjects []*iotevents.TransitionEvent) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":     flattenIotEventsDetectorModelActions(apiObject.Actions),
+			"condition":  aws.StringValue(apiObject.Condition),
+			"event_name": aws.StringValue(apiObject.EventName),
+			"next_state": aws.StringValue(apiObject.NextState),
+		})
+	}
+
+	return tfList
+}
+
+func flattenIotEventsDetectorModelActions(apiObjects []*iotevents.ActionData) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.ClearTimer; v != nil {
+			tfMap["clear_timer"] = []interface{}{
+				map[string]interface{}{
+					"timer_name": aws.StringValue(v.TimerName),
+				},
+			}
+		}
+
+		if v := apiObject.DynamoDB; v != nil {
+			tfMap["dynamodb"] = []interface{}{
+				map[string]interface{}{
+					"hash_key_field":  aws.StringValue(v.HashKeyField),
+					"hash_key_type":   aws.StringValue(v.HashKeyType),
+					"hash_key_value":  aws.StringValue(v.HashKeyValue),
+					"operation":       aws.StringValue(v.Operation),
+					"payload":         flattenIotEventsDetectorModelPayload(v.Payload),
+					"payload_field":   aws.StringValue(v.PayloadField),
+					"range_key_field": aws.StringValue(v.RangeKeyField),
+					"range_key_type":  aws.StringValue(v.RangeKeyType),
+					"range_key_value": aws.StringValue(v.RangeKeyValue),
+					"table_name":      aws.StringValue(v.TableName),
+				},
+			}
+		}
+
+		if v := apiObject.DynamoDBv2; v != nil {
+			tfMap["dynamodbv2"] = []interface{}{
+				map[string]interface{}{
+					"payload":    flattenIotEventsDetectorModelPayload(v.Payload),
+					"table_name": aws.StringValue(v.TableName),
+				},
+			}
+		}
+
+		if v := apiObject.Firehose; v != nil {
+			tfMap["firehose"] = []interface{}{
+				map[string]interface{}{
+					"delivery_stream_name": aws.StringValue(v.DeliveryStreamName),
+					"payload":              flattenIotEventsDetectorModelPayload(v.Payload),
+					"separator":            aws.StringValue(v.Separator),
+				},
+			}
+		}
+
+		if v := apiObject.IotEvents; v != nil {
+			tfMap["iot_events"] = []interface{}{
+				map[string]interface{}{
+					"input_name": aws.StringValue(v.InputName),
+					"payload":    flattenIotEventsDetectorModelPayload(v.Payload),
+				},
+			}
+		}
+
+		if v := apiObject.IotSiteWise; v != nil {
+			tfMap["iot_site_wise"] = []interface{}{flattenIotEventsDetectorModelIotSiteWiseAction(v)}
+		}
+
+		if v := apiObject.IotTopicPublish; v != nil {
+			tfMap["iot_topic_publish"] = []interface{}{
+				map[string]interface{}{
+					"mqtt_topic": aws.StringValue(v.MqttTopic),
+					"payload":    flattenIotEventsDetectorModelPayload(v.Payload),
+				},
+			}
+		}
+
+		if v := apiObject.Lambda; v != nil {
+			tfMap["lambda"] = []interface{}{
+				map[string]interface{}{
+					"function_arn": aws.StringValue(v.FunctionArn),
+					"payload":      flattenIotEventsDetectorModelPayload(v.Payload),
+				},
+			}
+		}
+
+		if v := apiObject.ResetTimer; v != nil {
+			tfMap["reset_timer"] = []interface{}{
+				map[string]interface{}{
+					"timer_name": aws.StringValue(v.TimerName),
+				},
+			}
+		}
+
+		if v := apiObject.SetTimer; v != nil {
+			tfMap["set_timer"] = []interface{}{
+				map[string]interface{}{
+					"duration_expression": aws.StringValue(v.DurationExpression),
+					"seconds":             aws.Int64Value(v.Seconds),
+					"timer_name":          aws.StringValue(v.TimerName),
+				},
+			}
+		}
+
+		if v := apiObject.SetVariable; v != nil {
+			tfMap["set_variable"] = []interface{}{
+				map[string]interface{}{
+					"value":         aws.StringValue(v.Value),
+					"variable_name": aws.StringValue(v.VariableName),
+				},
+			}
+		}
+
+		if v := apiObject.Sns; v != nil {
+			tfMap["sns"] = []interface{}{
+				map[string]interface{}{
+					"payload":    flattenIotEventsDetectorModelPayload(v.Payload),
+					"target_arn": aws.StringValue(v.TargetArn),
+				},
+			}
+		}
+
+		if v := apiObject.Sqs; v != nil {
+			tfMap["sqs"] = []interface{}{
+				map[string]interface{}{
+					"payload":    flattenIotEventsDetectorModelPayload(v.Payload),
+					"queue_url":  aws.StringValue(v.QueueUrl),
+					"use_base64": aws.BoolValue(v.UseBase64),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenIotEventsDetectorModelIotSiteWiseAction(apiObject *iotevents.IotSiteWiseAction) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"asset_id":       aws.StringValue(apiObject.AssetId),
+		"entry_id":       aws.StringValue(apiObject.EntryId),
+		"property_alias": aws.StringValue(apiObject.PropertyAlias),
+		"property_id":    aws.StringValue(apiObject.PropertyId),
+	}
+
+	if v := apiObject.PropertyValue; v != nil {
+		propertyValue := map[string]interface{}{
+			"quality": aws.StringValue(v.Quality),
+		}
+
+		if v := v.Timestamp; v != nil {
+			propertyValue["timestamp"] = []interface{}{
+				map[string]interface{}{
+					"offset_in_nanos": aws.StringValue(v.OffsetInNanos),
+					"time_in_seconds": aws.StringValue(v.TimeInSeconds),
+				},
+			}
+		}
+
+		if v := v.Value; v != nil {
+			propertyValue["value"] = []interface{}{
+				map[string]interface{}{
+					"boolean_value": aws.StringValue(v.BooleanValue),
+					"double_value":  aws.StringValue(v.DoubleValue),
+					"integer_value": aws.StringValue(v.IntegerValue),
+					"string_value":  aws.StringValue(v.StringValue),
+				},
+			}
+		}
+
+		tfMap["property_value"] = []interface{}{propertyValue}
+	}
+
+	return tfMap
+}
+
+func flattenIotEventsDetectorModelPayload(apiObject *iotevents.Payload) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"content_expression": aws.StringValue(apiObject.ContentExpression),
+			"type":               aws.StringValue(apiObject.Type),
+		},
+	}
+}
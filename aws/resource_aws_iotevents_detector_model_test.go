@@ -0,0 +1,555 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotevents_detector_model", &resource.Sweeper{
+		Name: "aws_iotevents_detector_model",
+		F:    testSweepIotEventsDetectorModels,
+	})
+}
+
+func testSweepIotEventsDetectorModels(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).ioteventsconn
+	var sweeperErrs *multierror.Error
+	input := &iotevents.ListDetectorModelsInput{}
+
+	for {
+		output, err := conn.ListDetectorModels(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Events Detector Model sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing IoT Events Detector Models: %w", err))
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		for _, detectorModelSummary := range output.DetectorModelSummaries {
+			if detectorModelSummary == nil {
+				continue
+			}
+
+			name := aws.StringValue(detectorModelSummary.DetectorModelName)
+			r := resourceAwsIotEventsDetectorModel()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			log.Printf("[INFO] Deleting IoT Events Detector Model: %s", name)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting IoT Events Detector Model (%s): %w", name, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSIotEventsDetectorModel_basic(t *testing.T) {
+	var v iotevents.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "iotevents", regexp.MustCompile(fmt.Sprintf("detectorModel/%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.initial_state_name", "normal"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.state_name", "normal"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_enter.0.event.0.event_name", "init"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_enter.0.event.0.action.0.set_variable.0.variable_name", "threshold"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.0.on_input.0.transition_event.0.next_state", "alarm"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.state_name", "alarm"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.0.event.0.action.0.set_timer.0.timer_name", "cooldown"),
+					resource.TestCheckResourceAttrSet(resourceName, "definition_json"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", iotevents.EvaluationMethodBatch),
+					resource.TestCheckResourceAttr(resourceName, "key", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition"},
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_enter.0.event.0.action.0.set_timer.0.duration_expression", "120"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.state.1.on_input.0.transition_event.0.next_state", "normal"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", iotevents.EvaluationMethodSerial),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_disappears(t *testing.T) {
+	var v iotevents.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotEventsDetectorModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_DefinitionJson(t *testing.T) {
+	var v iotevents.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigDefinitionJson(rName, "normal"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "definition_json"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigDefinitionJson(rName, "idle"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_tags(t *testing.T) {
+	var v iotevents.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotEventsDetectorModelExists(resourceName string, v *iotevents.DetectorModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Detector Model ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+		output, err := finder.DetectorModelByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("IoT Events Detector Model (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotEventsDetectorModelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotevents_detector_model" {
+			continue
+		}
+
+		output, err := finder.DetectorModelByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			continue
+		}
+
+		return fmt.Errorf("IoT Events Detector Model (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotEventsDetectorModelConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "iotevents.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iotevents_input" "test" {
+  name = replace(%[1]q, "-", "_")
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSIotEventsDetectorModelConfig(rName string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  definition {
+    initial_state_name = "normal"
+
+    state {
+      state_name = "normal"
+
+      on_enter {
+        event {
+          event_name = "init"
+          condition  = "true"
+
+          action {
+            set_variable {
+              variable_name = "threshold"
+              value         = "50"
+            }
+          }
+        }
+      }
+
+      on_input {
+        transition_event {
+          event_name = "too_hot"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature > $variable.threshold"
+          next_state = "alarm"
+        }
+      }
+    }
+
+    state {
+      state_name = "alarm"
+
+      on_enter {
+        event {
+          event_name = "start_cooldown"
+
+          action {
+            set_timer {
+              timer_name = "cooldown"
+              seconds    = 60
+            }
+          }
+        }
+      }
+
+      on_input {
+        transition_event {
+          event_name = "cooled_down"
+          condition  = "timeout(\"cooldown\")"
+          next_state = "normal"
+
+          action {
+            clear_timer {
+              timer_name = "cooldown"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSIotEventsDetectorModelConfigUpdated(rName string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name              = %[1]q
+  description       = "updated"
+  evaluation_method = "SERIAL"
+  role_arn          = aws_iam_role.test.arn
+
+  definition {
+    initial_state_name = "normal"
+
+    state {
+      state_name = "normal"
+
+      on_enter {
+        event {
+          event_name = "init"
+          condition  = "true"
+
+          action {
+            set_variable {
+              variable_name = "threshold"
+              value         = "50"
+            }
+          }
+        }
+      }
+
+      on_input {
+        transition_event {
+          event_name = "too_hot"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature > $variable.threshold"
+          next_state = "alarm"
+        }
+      }
+    }
+
+    state {
+      state_name = "alarm"
+
+      on_enter {
+        event {
+          event_name = "start_cooldown"
+
+          action {
+            set_timer {
+              timer_name          = "cooldown"
+              duration_expression = "120"
+            }
+          }
+        }
+      }
+
+      on_input {
+        transition_event {
+          event_name = "cooled_down"
+          condition  = "timeout(\"cooldown\")"
+          next_state = "normal"
+
+          action {
+            clear_timer {
+              timer_name = "cooldown"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSIotEventsDetectorModelConfigDefinitionJson(rName, initialStateName string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  definition_json = jsonencode({
+    initialStateName = %[2]q
+    states = [
+      {
+        stateName = %[2]q
+        onInput = {
+          events = [
+            {
+              eventName = "record"
+              condition = "true"
+              actions = [
+                {
+                  setVariable = {
+                    variableName = "temperature"
+                    value        = "$input.${aws_iotevents_input.test.name}.temperature"
+                  }
+                },
+              ]
+            },
+          ]
+        }
+      },
+    ]
+  })
+}
+`, rName, initialStateName))
+}
+
+func testAccAWSIotEventsDetectorModelConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  definition {
+    initial_state_name = "normal"
+
+    state {
+      state_name = "normal"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSIotEventsDetectorModelConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  definition {
+    initial_state_name = "normal"
+
+    state {
+      state_name = "normal"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,317 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iotevents_input", &resource.Sweeper{
+		Name: "aws_iotevents_input",
+		F:    testSweepIotEventsInputs,
+		Dependencies: []string{
+			"aws_iotevents_detector_model",
+		},
+	})
+}
+
+func testSweepIotEventsInputs(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).ioteventsconn
+	var sweeperErrs *multierror.Error
+	input := &iotevents.ListInputsInput{}
+
+	for {
+		output, err := conn.ListInputs(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping IoT Events Input sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing IoT Events Inputs: %w", err))
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		for _, inputSummary := range output.InputSummaries {
+			if inputSummary == nil {
+				continue
+			}
+
+			name := aws.StringValue(inputSummary.InputName)
+			r := resourceAwsIotEventsInput()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			log.Printf("[INFO] Deleting IoT Events Input: %s", name)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting IoT Events Input (%s): %w", name, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSIotEventsInput_basic(t *testing.T) {
+	var v iotevents.Input
+	resourceName := "aws_iotevents_input.test"
+	rName := strings.ReplaceAll(acctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsInputConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "iotevents", regexp.MustCompile(fmt.Sprintf("input/%s$", rName))),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.0.json_path", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotEventsInputConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.0.json_path", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.1.json_path", "sensor.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsInput_disappears(t *testing.T) {
+	var v iotevents.Input
+	resourceName := "aws_iotevents_input.test"
+	rName := strings.ReplaceAll(acctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsInputConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotEventsInput(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsInput_tags(t *testing.T) {
+	var v iotevents.Input
+	resourceName := "aws_iotevents_input.test"
+	rName := strings.ReplaceAll(acctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(iotevents.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsInputConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIotEventsInputConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotEventsInputConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsInputExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotEventsInputExists(resourceName string, v *iotevents.Input) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Input ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+		output, err := finder.InputByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("IoT Events Input (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIotEventsInputDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotevents_input" {
+			continue
+		}
+
+		output, err := finder.InputByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			continue
+		}
+
+		return fmt.Errorf("IoT Events Input (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotEventsInputConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSIotEventsInputConfigUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name        = %[1]q
+  description = "updated"
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+
+    attribute {
+      json_path = "sensor.id"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSIotEventsInputConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSIotEventsInputConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
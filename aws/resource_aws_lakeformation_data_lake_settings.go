@@ -0,0 +1,299 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+)
+
+func resourceAwsLakeFormationDataLakeSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationDataLakeSettingsCreate,
+		Read:   resourceAwsLakeFormationDataLakeSettingsRead,
+		Update: resourceAwsLakeFormationDataLakeSettingsCreate,
+		Delete: resourceAwsLakeFormationDataLakeSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"admins": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"create_database_default_permissions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     lakeFormationPrincipalPermissionsSchema(),
+			},
+			"create_table_default_permissions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     lakeFormationPrincipalPermissionsSchema(),
+			},
+			"trusted_resource_owners": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAwsAccountId,
+				},
+			},
+		},
+	}
+}
+
+func lakeFormationPrincipalPermissionsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"permissions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+				},
+			},
+			"principal": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateLakeFormationPrincipal,
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationDataLakeSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	catalogID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("catalog_id"); ok {
+		catalogID = v.(string)
+	}
+
+	settings := &lakeformation.DataLakeSettings{}
+
+	if v, ok := d.GetOk("admins"); ok {
+		settings.DataLakeAdmins = expandLakeFormationDataLakePrincipals(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("create_database_default_permissions"); ok {
+		settings.CreateDatabaseDefaultPermissions = expandLakeFormationPrincipalPermissions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("create_table_default_permissions"); ok {
+		settings.CreateTableDefaultPermissions = expandLakeFormationPrincipalPermissions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("trusted_resource_owners"); ok {
+		settings.TrustedResourceOwners = expandStringList(v.([]interface{}))
+	}
+
+	input := &lakeformation.PutDataLakeSettingsInput{
+		CatalogId:        aws.String(catalogID),
+		DataLakeSettings: settings,
+	}
+
+	log.Printf("[DEBUG] Putting Lake Formation Data Lake Settings: %s", input)
+	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+		_, err := conn.PutDataLakeSettings(input)
+
+		if isAWSErr(err, lakeformation.ErrCodeInvalidInputException, "Invalid principal") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.PutDataLakeSettings(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error putting Lake Formation Data Lake Settings (%s): %w", catalogID, err)
+	}
+
+	d.SetId(catalogID)
+
+	return resourceAwsLakeFormationDataLakeSettingsRead(d, meta)
+}
+
+func resourceAwsLakeFormationDataLakeSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	output, err := conn.GetDataLakeSettings(&lakeformation.GetDataLakeSettingsInput{
+		CatalogId: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Lake Formation Data Lake Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Data Lake Settings (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.DataLakeSettings == nil {
+		return fmt.Errorf("error reading Lake Formation Data Lake Settings (%s): empty response", d.Id())
+	}
+
+	settings := output.DataLakeSettings
+
+	d.Set("catalog_id", d.Id())
+
+	if err := d.Set("admins", flattenLakeFormationDataLakePrincipals(settings.DataLakeAdmins)); err != nil {
+		return fmt.Errorf("error setting admins: %w", err)
+	}
+
+	if err := d.Set("create_database_default_permissions", flattenLakeFormationPrincipalPermissions(settings.CreateDatabaseDefaultPermissions)); err != nil {
+		return fmt.Errorf("error setting create_database_default_permissions: %w", err)
+	}
+
+	if err := d.Set("create_table_default_permissions", flattenLakeFormationPrincipalPermissions(settings.CreateTableDefaultPermissions)); err != nil {
+		return fmt.Errorf("error setting create_table_default_permissions: %w", err)
+	}
+
+	if err := d.Set("trusted_resource_owners", flattenStringList(settings.TrustedResourceOwners)); err != nil {
+		return fmt.Errorf("error setting trusted_resource_owners: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationDataLakeSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.PutDataLakeSettingsInput{
+		CatalogId: aws.String(d.Id()),
+		DataLakeSettings: &lakeformation.DataLakeSettings{
+			CreateDatabaseDefaultPermissions: make([]*lakeformation.PrincipalPermissions, 0),
+			CreateTableDefaultPermissions:    make([]*lakeformation.PrincipalPermissions, 0),
+			DataLakeAdmins:                   make([]*lakeformation.DataLakePrincipal, 0),
+			TrustedResourceOwners:            make([]*string, 0),
+		},
+	}
+
+	log.Printf("[DEBUG] Resetting Lake Formation Data Lake Settings (%s)", d.Id())
+	_, err := conn.PutDataLakeSettings(input)
+
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error resetting Lake Formation Data Lake Settings (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLakeFormationDataLakePrincipals(tfList []interface{}) []*lakeformation.DataLakePrincipal {
+	var apiObjects []*lakeformation.DataLakePrincipal
+
+	for _, tfItem := range tfList {
+		v, ok := tfItem.(string)
+
+		if !ok || v == "" {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenLakeFormationDataLakePrincipals(apiObjects []*lakeformation.DataLakePrincipal) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.DataLakePrincipalIdentifier))
+	}
+
+	return tfList
+}
+
+func expandLakeFormationPrincipalPermissions(tfList []interface{}) []*lakeformation.PrincipalPermissions {
+	var apiObjects []*lakeformation.PrincipalPermissions
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lakeformation.PrincipalPermissions{}
+
+		if v, ok := tfMap["permissions"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Permissions = expandStringSet(v)
+		}
+
+		if v, ok := tfMap["principal"].(string); ok && v != "" {
+			apiObject.Principal = &lakeformation.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(v),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLakeFormationPrincipalPermissions(apiObjects []*lakeformation.PrincipalPermissions) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"permissions": flattenStringSet(apiObject.Permissions),
+		}
+
+		if apiObject.Principal != nil {
+			tfMap["principal"] = aws.StringValue(apiObject.Principal.DataLakePrincipalIdentifier)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
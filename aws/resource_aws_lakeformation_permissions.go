@@ -0,0 +1,674 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+	tflakeformation "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation/waiter"
+)
+
+func resourceAwsLakeFormationPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationPermissionsCreate,
+		Read:   resourceAwsLakeFormationPermissionsRead,
+		Delete: resourceAwsLakeFormationPermissionsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"catalog_resource": {
+				Type:     schema.TypeBool,
+				ForceNew: true,
+				Optional: true,
+				Default:  false,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"table",
+					"table_with_columns",
+				},
+			},
+			"data_location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+					},
+				},
+			},
+			"database": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"permissions": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+				},
+			},
+			"permissions_with_grant_option": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+				},
+			},
+			"principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLakeFormationPrincipal,
+			},
+			"table": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							AtLeastOneOf: []string{
+								"table.0.name",
+								"table.0.wildcard",
+							},
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+							AtLeastOneOf: []string{
+								"table.0.name",
+								"table.0.wildcard",
+							},
+						},
+					},
+				},
+			},
+			"table_with_columns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_location",
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+							AtLeastOneOf: []string{
+								"table_with_columns.0.column_names",
+								"table_with_columns.0.wildcard",
+							},
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"excluded_column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+							AtLeastOneOf: []string{
+								"table_with_columns.0.column_names",
+								"table_with_columns.0.wildcard",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.GrantPermissionsInput{
+		Permissions: expandStringList(d.Get("permissions").([]interface{})),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: &lakeformation.Resource{},
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("permissions_with_grant_option"); ok {
+		input.PermissionsWithGrantOption = expandStringList(v.([]interface{}))
+	}
+
+	if _, ok := d.GetOk("catalog_resource"); ok {
+		input.Resource.Catalog = &lakeformation.CatalogResource{}
+	}
+
+	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.DataLocation = expandLakeFormationDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.Database = expandLakeFormationDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.Table = expandLakeFormationTableResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table_with_columns"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.TableWithColumns = expandLakeFormationTableWithColumnsResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Granting Lake Formation Permissions: %s", input)
+	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+		_, err := conn.GrantPermissions(input)
+
+		if isAWSErr(err, lakeformation.ErrCodeInvalidInputException, "Invalid principal") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, lakeformation.ErrCodeInvalidInputException, "Grantee has no permissions") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, lakeformation.ErrCodeInvalidInputException, "register the S3 path") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, lakeformation.ErrCodeConcurrentModificationException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.GrantPermissions(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error granting Lake Formation Permissions (input: %s): %w", input, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(input.String())))
+
+	return resourceAwsLakeFormationPermissionsRead(d, meta)
+}
+
+func resourceAwsLakeFormationPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input, tableType, columnNames, excludedColumnNames, columnWildcard := expandLakeFormationListPermissionsInput(d)
+
+	var permissions []*lakeformation.PrincipalResourcePermissions
+	var err error
+
+	if d.IsNewResource() {
+		// Granted permissions are eventually consistent.
+		permissions, err = waiter.PermissionsReady(conn, input, tableType, columnNames, excludedColumnNames, columnWildcard)
+	} else {
+		permissions, err = finder.Permissions(conn, input, tableType, columnNames, excludedColumnNames, columnWildcard)
+	}
+
+	if !d.IsNewResource() && isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Lake Formation Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Permissions (%s): %w", d.Id(), err)
+	}
+
+	if len(permissions) == 0 {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Lake Formation Permissions (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] Lake Formation Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := flattenLakeFormationPrincipalResourcePermissionsToResourceData(d, permissions); err != nil {
+		return fmt.Errorf("error reading Lake Formation Permissions (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	input := &lakeformation.RevokePermissionsInput{
+		Permissions:                expandStringList(d.Get("permissions").([]interface{})),
+		PermissionsWithGrantOption: expandStringList(d.Get("permissions_with_grant_option").([]interface{})),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: &lakeformation.Resource{},
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if _, ok := d.GetOk("catalog_resource"); ok {
+		input.Resource.Catalog = &lakeformation.CatalogResource{}
+	}
+
+	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.DataLocation = expandLakeFormationDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.Database = expandLakeFormationDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.Table = expandLakeFormationTableResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table_with_columns"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.TableWithColumns = expandLakeFormationTableWithColumnsResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Revoking Lake Formation Permissions (%s)", d.Id())
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.RevokePermissions(input)
+
+		if isAWSErr(err, lakeformation.ErrCodeConcurrentModificationException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.RevokePermissions(input)
+	}
+
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+
+	// Revoking permissions that are not (or no longer) granted.
+	if isAWSErr(err, lakeformation.ErrCodeInvalidInputException, "No permissions revoked") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error revoking Lake Formation Permissions (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// expandLakeFormationListPermissionsInput returns the ListPermissions input
+// for the resource configuration along with the additional table with columns
+// arguments needed to filter the results, as ListPermissions does not support
+// listing by table with columns.
+func expandLakeFormationListPermissionsInput(d *schema.ResourceData) (*lakeformation.ListPermissionsInput, string, []*string, []*string, bool) {
+	input := &lakeformation.ListPermissionsInput{
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: &lakeformation.Resource{},
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if _, ok := d.GetOk("catalog_resource"); ok {
+		input.Resource.Catalog = &lakeformation.CatalogResource{}
+		input.ResourceType = aws.String(lakeformation.DataLakeResourceTypeCatalog)
+	}
+
+	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.DataLocation = expandLakeFormationDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
+		input.ResourceType = aws.String(lakeformation.DataLakeResourceTypeDataLocation)
+	}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.Database = expandLakeFormationDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
+		input.ResourceType = aws.String(lakeformation.DataLakeResourceTypeDatabase)
+	}
+
+	tableType := ""
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.Table = expandLakeFormationTableResource(v.([]interface{})[0].(map[string]interface{}))
+		input.ResourceType = aws.String(lakeformation.DataLakeResourceTypeTable)
+		tableType = tflakeformation.TableTypeTable
+	}
+
+	var columnNames []*string
+	var excludedColumnNames []*string
+	columnWildcard := false
+
+	if v, ok := d.GetOk("table_with_columns"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		// ListPermissions does not support table with columns resources.
+		input.Resource.Table = expandLakeFormationTableResourceFromTableWithColumns(tfMap)
+		input.ResourceType = aws.String(lakeformation.DataLakeResourceTypeTable)
+		tableType = tflakeformation.TableTypeTableWithColumns
+
+		if v, ok := tfMap["column_names"].(*schema.Set); ok {
+			columnNames = expandStringSet(v)
+		}
+
+		if v, ok := tfMap["excluded_column_names"].(*schema.Set); ok {
+			excludedColumnNames = expandStringSet(v)
+		}
+
+		if v, ok := tfMap["wildcard"].(bool); ok {
+			columnWildcard = v
+		}
+	}
+
+	return input, tableType, columnNames, excludedColumnNames, columnWildcard
+}
+
+// flattenLakeFormationPrincipalResourcePermissionsToResourceData sets the
+// principal and permissions attributes from the listed permissions.
+func flattenLakeFormationPrincipalResourcePermissionsToResourceData(d *schema.ResourceData, allPermissions []*lakeformation.PrincipalResourcePermissions) error {
+	d.Set("principal", allPermissions[0].Principal.DataLakePrincipalIdentifier)
+
+	var permissions []*string
+	var permissionsWithGrantOption []*string
+
+	for _, permission := range allPermissions {
+		permissions = append(permissions, permission.Permissions...)
+		permissionsWithGrantOption = append(permissionsWithGrantOption, permission.PermissionsWithGrantOption...)
+	}
+
+	// Lake Formation reports SELECT on a whole table as a column wildcard, and
+	// permissions granted on a table across separate entries, so only update
+	// the configured permissions when the sets actually differ.
+	if !tflakeformation.StringSetsEqual(permissions, expandStringList(d.Get("permissions").([]interface{}))) {
+		if err := d.Set("permissions", flattenStringList(uniqueStringPointers(permissions))); err != nil {
+			return fmt.Errorf("error setting permissions: %w", err)
+		}
+	}
+
+	if !tflakeformation.StringSetsEqual(permissionsWithGrantOption, expandStringList(d.Get("permissions_with_grant_option").([]interface{}))) {
+		if err := d.Set("permissions_with_grant_option", flattenStringList(uniqueStringPointers(permissionsWithGrantOption))); err != nil {
+			return fmt.Errorf("error setting permissions_with_grant_option: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func uniqueStringPointers(apiObjects []*string) []*string {
+	var result []*string
+	seen := make(map[string]bool)
+
+	for _, apiObject := range apiObjects {
+		v := aws.StringValue(apiObject)
+
+		if seen[v] {
+			continue
+		}
+
+		seen[v] = true
+		result = append(result, apiObject)
+	}
+
+	return result
+}
+
+func expandLakeFormationDataLocationResource(tfMap map[string]interface{}) *lakeformation.DataLocationResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.DataLocationResource{}
+
+	if v, ok := tfMap["arn"].(string); ok && v != "" {
+		apiObject.ResourceArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLakeFormationDatabaseResource(tfMap map[string]interface{}) *lakeformation.DatabaseResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.DatabaseResource{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLakeFormationTableResource(tfMap map[string]interface{}) *lakeformation.TableResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.TableResource{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["wildcard"].(bool); ok && v {
+		apiObject.TableWildcard = &lakeformation.TableWildcard{}
+	}
+
+	return apiObject
+}
+
+func expandLakeFormationTableResourceFromTableWithColumns(tfMap map[string]interface{}) *lakeformation.TableResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.TableResource{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLakeFormationTableWithColumnsResource(tfMap map[string]interface{}) *lakeformation.TableWithColumnsResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.TableWithColumnsResource{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["column_names"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ColumnNames = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["wildcard"].(bool); ok && v {
+		apiObject.ColumnWildcard = &lakeformation.ColumnWildcard{}
+
+		if v, ok := tfMap["excluded_column_names"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ColumnWildcard.ExcludedColumnNames = expandStringSet(v)
+		}
+	}
+
+	return apiObject
+}
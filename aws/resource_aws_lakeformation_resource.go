@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation/finder"
+)
+
+func resourceAwsLakeFormationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationResourceCreate,
+		Read:   resourceAwsLakeFormationResourceRead,
+		Delete: resourceAwsLakeFormationResourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	resourceArn := d.Get("arn").(string)
+
+	input := &lakeformation.RegisterResourceInput{
+		ResourceArn: aws.String(resourceArn),
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	} else {
+		input.UseServiceLinkedRole = aws.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Registering Lake Formation Resource: %s", input)
+	_, err := conn.RegisterResource(input)
+
+	if isAWSErr(err, lakeformation.ErrCodeAlreadyExistsException, "") {
+		log.Printf("[WARN] Lake Formation Resource (%s) already registered", resourceArn)
+	} else if err != nil {
+		return fmt.Errorf("error registering Lake Formation Resource (%s): %w", resourceArn, err)
+	}
+
+	d.SetId(resourceArn)
+
+	return resourceAwsLakeFormationResourceRead(d, meta)
+}
+
+func resourceAwsLakeFormationResourceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	resourceInfo, err := finder.Resource(conn, d.Id())
+
+	if !d.IsNewResource() && isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Lake Formation Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Resource (%s): %w", d.Id(), err)
+	}
+
+	if resourceInfo == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Lake Formation Resource (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] Lake Formation Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", resourceInfo.ResourceArn)
+	d.Set("role_arn", resourceInfo.RoleArn)
+
+	if resourceInfo.LastModified != nil {
+		d.Set("last_modified", resourceInfo.LastModified.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	log.Printf("[DEBUG] Deregistering Lake Formation Resource (%s)", d.Id())
+	_, err := conn.DeregisterResource(&lakeformation.DeregisterResourceInput{
+		ResourceArn: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering Lake Formation Resource (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccAwsMacie2Account_basic(t *testing.T) {
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencyFifteenMinutes),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+					testAccCheckResourceAttrGlobalARN(resourceName, "service_role", "iam", "role/aws-service-role/macie.amazonaws.com/AWSServiceRoleForAmazonMacie"),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_at"),
+					testAccCheckResourceAttrRfc3339(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Account_disappears(t *testing.T) {
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2Account(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Account_FindingPublishingFrequency(t *testing.T) {
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountConfigFindingPublishingFrequency(macie2.FindingPublishingFrequencyFifteenMinutes),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencyFifteenMinutes),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsMacie2AccountConfigFindingPublishingFrequency(macie2.FindingPublishingFrequencyOneHour),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "finding_publishing_frequency", macie2.FindingPublishingFrequencyOneHour),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Account_Status(t *testing.T) {
+	resourceName := "aws_macie2_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2AccountConfigStatus(macie2.MacieStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				Config: testAccAwsMacie2AccountConfigStatus(macie2.MacieStatusPaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2AccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", macie2.MacieStatusPaused),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2AccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_account" {
+			continue
+		}
+
+		output, err := conn.GetMacieSession(&macie2.GetMacieSessionInput{})
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) || isMacie2NotEnabledError(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Macie Account (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMacie2AccountExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		_, err := conn.GetMacieSession(&macie2.GetMacieSessionInput{})
+
+		return err
+	}
+}
+
+func testAccAwsMacie2AccountConfig() string {
+	return `
+resource "aws_macie2_account" "test" {}
+`
+}
+
+func testAccAwsMacie2AccountConfigFindingPublishingFrequency(frequency string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {
+  finding_publishing_frequency = %[1]q
+}
+`, frequency)
+}
+
+func testAccAwsMacie2AccountConfigStatus(status string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {
+  status = %[1]q
+}
+`, status)
+}
@@ -0,0 +1,714 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+)
+
+func resourceAwsMacie2ClassificationJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMacie2ClassificationJobCreate,
+		Read:   resourceAwsMacie2ClassificationJobRead,
+		Update: resourceAwsMacie2ClassificationJobUpdate,
+		Delete: resourceAwsMacie2ClassificationJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_data_identifier_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"initial_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"job_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					macie2.JobStatusRunning,
+					macie2.JobStatusUserPaused,
+				}, false),
+			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(macie2.JobType_Values(), false),
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validation.StringLenBetween(1, 500),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validation.StringLenBetween(1, 500-resource.UniqueIDSuffixLength),
+			},
+			"s3_job_definition": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_definitions": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateAwsAccountId,
+									},
+									"buckets": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"scoping": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"excludes": macie2JobScopingBlockSchema(),
+									"includes": macie2JobScopingBlockSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"sampling_percentage": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"schedule_frequency": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily_schedule": {
+							Type:         schema.TypeBool,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"schedule_frequency.0.daily_schedule", "schedule_frequency.0.weekly_schedule", "schedule_frequency.0.monthly_schedule"},
+						},
+						"monthly_schedule": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 31),
+							ExactlyOneOf: []string{"schedule_frequency.0.daily_schedule", "schedule_frequency.0.weekly_schedule", "schedule_frequency.0.monthly_schedule"},
+						},
+						"weekly_schedule": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(macie2.DayOfWeek_Values(), false),
+							ExactlyOneOf: []string{"schedule_frequency.0.daily_schedule", "schedule_frequency.0.weekly_schedule", "schedule_frequency.0.monthly_schedule"},
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func macie2JobScopingBlockSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"and": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"simple_scope_term": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"comparator": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ForceNew:     true,
+											Default:      macie2.JobComparatorEq,
+											ValidateFunc: validation.StringInSlice(macie2.JobComparator_Values(), false),
+										},
+										"key": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringInSlice(macie2.ScopeFilterKey_Values(), false),
+										},
+										"values": {
+											Type:     schema.TypeList,
+											Required: true,
+											ForceNew: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+									},
+								},
+							},
+							"tag_scope_term": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"comparator": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ForceNew:     true,
+											Default:      macie2.JobComparatorEq,
+											ValidateFunc: validation.StringInSlice(macie2.JobComparator_Values(), false),
+										},
+										"key": {
+											Type:     schema.TypeString,
+											Required: true,
+											ForceNew: true,
+										},
+										"tag_values": {
+											Type:     schema.TypeList,
+											Required: true,
+											ForceNew: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"key": {
+														Type:     schema.TypeString,
+														Required: true,
+														ForceNew: true,
+													},
+													"value": {
+														Type:     schema.TypeString,
+														Optional: true,
+														ForceNew: true,
+													},
+												},
+											},
+										},
+										"target": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ForceNew:     true,
+											Default:      macie2.TagTargetS3Object,
+											ValidateFunc: validation.StringInSlice(macie2.TagTarget_Values(), false),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMacie2ClassificationJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
+
+	input := &macie2.CreateClassificationJobInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		JobType:     aws.String(d.Get("job_type").(string)),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("custom_data_identifier_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.CustomDataIdentifierIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("initial_run"); ok {
+		input.InitialRun = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("s3_job_definition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.S3JobDefinition = expandMacie2S3JobDefinition(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("sampling_percentage"); ok {
+		input.SamplingPercentage = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("schedule_frequency"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ScheduleFrequency = expandMacie2JobScheduleFrequency(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().Macie2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Macie Classification Job: %s", input)
+	output, err := conn.CreateClassificationJob(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Macie Classification Job (%s): %w", name, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error creating Macie Classification Job (%s): empty response", name)
+	}
+
+	d.SetId(aws.StringValue(output.JobId))
+
+	if v, ok := d.GetOk("job_status"); ok && v.(string) == macie2.JobStatusUserPaused {
+		if err := resourceAwsMacie2ClassificationJobUpdateStatus(conn, d.Id(), v.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMacie2ClassificationJobRead(d, meta)
+}
+
+func resourceAwsMacie2ClassificationJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := conn.DescribeClassificationJob(&macie2.DescribeClassificationJobInput{
+		JobId: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) || isMacie2NotEnabledError(err)) {
+		log.Printf("[WARN] Macie Classification Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Classification Job (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading Macie Classification Job (%s): empty response", d.Id())
+	}
+
+	// Cancelled jobs cannot be restarted and are eventually removed by the service.
+	if !d.IsNewResource() && aws.StringValue(output.JobStatus) == macie2.JobStatusCancelled {
+		log.Printf("[WARN] Macie Classification Job (%s) cancelled, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+
+	if err := d.Set("custom_data_identifier_ids", flattenStringSet(output.CustomDataIdentifierIds)); err != nil {
+		return fmt.Errorf("error setting custom_data_identifier_ids: %w", err)
+	}
+
+	d.Set("description", output.Description)
+	d.Set("initial_run", output.InitialRun)
+	d.Set("job_arn", output.JobArn)
+	d.Set("job_id", output.JobId)
+	d.Set("job_status", output.JobStatus)
+	d.Set("job_type", output.JobType)
+	d.Set("name", output.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(output.Name)))
+
+	if err := d.Set("s3_job_definition", flattenMacie2S3JobDefinition(output.S3JobDefinition)); err != nil {
+		return fmt.Errorf("error setting s3_job_definition: %w", err)
+	}
+
+	d.Set("sampling_percentage", output.SamplingPercentage)
+
+	if err := d.Set("schedule_frequency", flattenMacie2JobScheduleFrequency(output.ScheduleFrequency)); err != nil {
+		return fmt.Errorf("error setting schedule_frequency: %w", err)
+	}
+
+	tags := keyvaluetags.Macie2KeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2ClassificationJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	if d.HasChange("job_status") {
+		if err := resourceAwsMacie2ClassificationJobUpdateStatus(conn, d.Id(), d.Get("job_status").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Macie2UpdateTags(conn, d.Get("job_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Macie Classification Job (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMacie2ClassificationJobRead(d, meta)
+}
+
+func resourceAwsMacie2ClassificationJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	log.Printf("[DEBUG] Cancelling Macie Classification Job: %s", d.Id())
+	_, err := conn.UpdateClassificationJob(&macie2.UpdateClassificationJobInput{
+		JobId:     aws.String(d.Id()),
+		JobStatus: aws.String(macie2.JobStatusCancelled),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) || isMacie2NotEnabledError(err) {
+		return nil
+	}
+
+	// Completed one-time jobs cannot be cancelled.
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeValidationException) {
+		log.Printf("[WARN] Unable to cancel Macie Classification Job (%s), removing from state: %s", d.Id(), err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling Macie Classification Job (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2ClassificationJobUpdateStatus(conn *macie2.Macie2, id, status string) error {
+	input := &macie2.UpdateClassificationJobInput{
+		JobId:     aws.String(id),
+		JobStatus: aws.String(status),
+	}
+
+	log.Printf("[DEBUG] Updating Macie Classification Job: %s", input)
+	_, err := conn.UpdateClassificationJob(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Macie Classification Job (%s) status to %s: %w", id, status, err)
+	}
+
+	return nil
+}
+
+func expandMacie2S3JobDefinition(tfMap map[string]interface{}) *macie2.S3JobDefinition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &macie2.S3JobDefinition{}
+
+	if v, ok := tfMap["bucket_definitions"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			bucketDefinition := &macie2.S3BucketDefinitionForJob{}
+
+			if v, ok := tfMap["account_id"].(string); ok && v != "" {
+				bucketDefinition.AccountId = aws.String(v)
+			}
+
+			if v, ok := tfMap["buckets"].(*schema.Set); ok && v.Len() > 0 {
+				bucketDefinition.Buckets = expandStringSet(v)
+			}
+
+			apiObject.BucketDefinitions = append(apiObject.BucketDefinitions, bucketDefinition)
+		}
+	}
+
+	if v, ok := tfMap["scoping"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		scoping := &macie2.Scoping{}
+
+		if v, ok := tfMap["excludes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			scoping.Excludes = expandMacie2JobScopingBlock(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["includes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			scoping.Includes = expandMacie2JobScopingBlock(v[0].(map[string]interface{}))
+		}
+
+		apiObject.Scoping = scoping
+	}
+
+	return apiObject
+}
+
+func expandMacie2JobScopingBlock(tfMap map[string]interface{}) *macie2.JobScopingBlock {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &macie2.JobScopingBlock{}
+
+	if v, ok := tfMap["and"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			term := &macie2.JobScopeTerm{}
+
+			if v, ok := tfMap["simple_scope_term"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+
+				term.SimpleScopeTerm = &macie2.SimpleScopeTerm{
+					Comparator: aws.String(tfMap["comparator"].(string)),
+					Key:        aws.String(tfMap["key"].(string)),
+					Values:     expandStringList(tfMap["values"].([]interface{})),
+				}
+			}
+
+			if v, ok := tfMap["tag_scope_term"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+
+				tagScopeTerm := &macie2.TagScopeTerm{
+					Comparator: aws.String(tfMap["comparator"].(string)),
+					Key:        aws.String(tfMap["key"].(string)),
+					Target:     aws.String(tfMap["target"].(string)),
+				}
+
+				for _, tfMapRaw := range tfMap["tag_values"].([]interface{}) {
+					tfMap, ok := tfMapRaw.(map[string]interface{})
+
+					if !ok {
+						continue
+					}
+
+					tagScopeTerm.TagValues = append(tagScopeTerm.TagValues, &macie2.TagValuePair{
+						Key:   aws.String(tfMap["key"].(string)),
+						Value: aws.String(tfMap["value"].(string)),
+					})
+				}
+
+				term.TagScopeTerm = tagScopeTerm
+			}
+
+			apiObject.And = append(apiObject.And, term)
+		}
+	}
+
+	return apiObject
+}
+
+func expandMacie2JobScheduleFrequency(tfMap map[string]interface{}) *macie2.JobScheduleFrequency {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &macie2.JobScheduleFrequency{}
+
+	if v, ok := tfMap["daily_schedule"].(bool); ok && v {
+		apiObject.DailySchedule = &macie2.DailySchedule{}
+	}
+
+	if v, ok := tfMap["monthly_schedule"].(int); ok && v != 0 {
+		apiObject.MonthlySchedule = &macie2.MonthlySchedule{
+			DayOfMonth: aws.Int64(int64(v)),
+		}
+	}
+
+	if v, ok := tfMap["weekly_schedule"].(string); ok && v != "" {
+		apiObject.WeeklySchedule = &macie2.WeeklySchedule{
+			DayOfWeek: aws.String(v),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenMacie2S3JobDefinition(apiObject *macie2.S3JobDefinition) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	var bucketDefinitions []interface{}
+
+	for _, bucketDefinition := range apiObject.BucketDefinitions {
+		if bucketDefinition == nil {
+			continue
+		}
+
+		bucketDefinitions = append(bucketDefinitions, map[string]interface{}{
+			"account_id": aws.StringValue(bucketDefinition.AccountId),
+			"buckets":    flattenStringSet(bucketDefinition.Buckets),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_definitions": bucketDefinitions,
+	}
+
+	if v := apiObject.Scoping; v != nil {
+		tfMap["scoping"] = []interface{}{
+			map[string]interface{}{
+				"excludes": flattenMacie2JobScopingBlock(v.Excludes),
+				"includes": flattenMacie2JobScopingBlock(v.Includes),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMacie2JobScopingBlock(apiObject *macie2.JobScopingBlock) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	var terms []interface{}
+
+	for _, term := range apiObject.And {
+		if term == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := term.SimpleScopeTerm; v != nil {
+			tfMap["simple_scope_term"] = []interface{}{
+				map[string]interface{}{
+					"comparator": aws.StringValue(v.Comparator),
+					"key":        aws.StringValue(v.Key),
+					"values":     aws.StringValueSlice(v.Values),
+				},
+			}
+		}
+
+		if v := term.TagScopeTerm; v != nil {
+			var tagValues []interface{}
+
+			for _, tagValue := range v.TagValues {
+				if tagValue == nil {
+					continue
+				}
+
+				tagValues = append(tagValues, map[string]interface{}{
+					"key":   aws.StringValue(tagValue.Key),
+					"value": aws.StringValue(tagValue.Value),
+				})
+			}
+
+			tfMap["tag_scope_term"] = []interface{}{
+				map[string]interface{}{
+					"comparator": aws.StringValue(v.Comparator),
+					"key":        aws.StringValue(v.Key),
+					"tag_values": tagValues,
+					"target":     aws.StringValue(v.Target),
+				},
+			}
+		}
+
+		terms = append(terms, tfMap)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"and": terms,
+		},
+	}
+}
+
+func flattenMacie2JobScheduleFrequency(apiObject *macie2.JobScheduleFrequency) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if apiObject.DailySchedule != nil {
+		tfMap["daily_schedule"] = true
+	}
+
+	if v := apiObject.MonthlySchedule; v != nil {
+		tfMap["monthly_schedule"] = aws.Int64Value(v.DayOfMonth)
+	}
+
+	if v := apiObject.WeeklySchedule; v != nil {
+		tfMap["weekly_schedule"] = aws.StringValue(v.DayOfWeek)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,425 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_macie2_classification_job", &resource.Sweeper{
+		Name: "aws_macie2_classification_job",
+		F:    testSweepMacie2ClassificationJobs,
+	})
+}
+
+func testSweepMacie2ClassificationJobs(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).macie2conn
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListClassificationJobsPages(&macie2.ListClassificationJobsInput{}, func(page *macie2.ListClassificationJobsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, job := range page.Items {
+			if job == nil {
+				continue
+			}
+
+			switch aws.StringValue(job.JobStatus) {
+			case macie2.JobStatusCancelled, macie2.JobStatusComplete:
+				continue
+			}
+
+			id := aws.StringValue(job.JobId)
+			r := resourceAwsMacie2ClassificationJob()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			log.Printf("[INFO] Cancelling Macie Classification Job: %s", id)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error cancelling Macie Classification Job (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) || isMacie2NotEnabledError(err) {
+		log.Printf("[WARN] Skipping Macie Classification Job sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Macie Classification Jobs: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func testAccAwsMacie2ClassificationJob_basic(t *testing.T) {
+	var output macie2.DescribeClassificationJobOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_classification_job.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigOneTime(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &output),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_at"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "job_arn", "macie2", regexp.MustCompile(`classification-job/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "job_type", macie2.JobTypeOneTime),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.bucket_definitions.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_job_definition.0.bucket_definitions.0.account_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "s3_job_definition.0.bucket_definitions.0.buckets.*", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"job_status"},
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_disappears(t *testing.T) {
+	var output macie2.DescribeClassificationJobOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_classification_job.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigScheduled(rName, "MONDAY", macie2.JobStatusRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &output),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2ClassificationJob(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_Scheduled(t *testing.T) {
+	var output macie2.DescribeClassificationJobOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_classification_job.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigScheduled(rName, "MONDAY", macie2.JobStatusRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "job_type", macie2.JobTypeScheduled),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.0.weekly_schedule", "MONDAY"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "s3_job_definition.0.scoping.0.excludes.0.and.0.simple_scope_term.0.key", macie2.ScopeFilterKeyObjectExtension),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_JobStatus(t *testing.T) {
+	var output macie2.DescribeClassificationJobOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_classification_job.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigScheduled(rName, "MONDAY", macie2.JobStatusRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "job_status", macie2.JobStatusRunning),
+				),
+			},
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigScheduled(rName, "MONDAY", macie2.JobStatusUserPaused),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "job_status", macie2.JobStatusUserPaused),
+				),
+			},
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigScheduled(rName, "MONDAY", macie2.JobStatusRunning),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "job_status", macie2.JobStatusRunning),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2ClassificationJob_Tags(t *testing.T) {
+	var output macie2.DescribeClassificationJobOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_classification_job.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2ClassificationJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsMacie2ClassificationJobConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2ClassificationJobExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2ClassificationJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_classification_job" {
+			continue
+		}
+
+		output, err := conn.DescribeClassificationJob(&macie2.DescribeClassificationJobInput{
+			JobId: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) || isMacie2NotEnabledError(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		switch aws.StringValue(output.JobStatus) {
+		case macie2.JobStatusCancelled, macie2.JobStatusComplete:
+			continue
+		}
+
+		return fmt.Errorf("Macie Classification Job (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMacie2ClassificationJobExists(resourceName string, v *macie2.DescribeClassificationJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		output, err := conn.DescribeClassificationJob(&macie2.DescribeClassificationJobInput{
+			JobId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Macie Classification Job (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAwsMacie2ClassificationJobConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccAwsMacie2ClassificationJobConfigOneTime(rName string) string {
+	return composeConfig(
+		testAccAwsMacie2ClassificationJobConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name     = %[1]q
+  job_type = "ONE_TIME"
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName))
+}
+
+func testAccAwsMacie2ClassificationJobConfigScheduled(rName, dayOfWeek, jobStatus string) string {
+	return composeConfig(
+		testAccAwsMacie2ClassificationJobConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name       = %[1]q
+  job_type   = "SCHEDULED"
+  job_status = %[3]q
+
+  schedule_frequency {
+    weekly_schedule = %[2]q
+  }
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+
+    scoping {
+      excludes {
+        and {
+          simple_scope_term {
+            comparator = "EQ"
+            key        = "OBJECT_EXTENSION"
+            values     = ["test"]
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, dayOfWeek, jobStatus))
+}
+
+func testAccAwsMacie2ClassificationJobConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAwsMacie2ClassificationJobConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name     = %[1]q
+  job_type = "SCHEDULED"
+
+  schedule_frequency {
+    daily_schedule = true
+  }
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAwsMacie2ClassificationJobConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAwsMacie2ClassificationJobConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_macie2_classification_job" "test" {
+  name     = %[1]q
+  job_type = "SCHEDULED"
+
+  schedule_frequency {
+    daily_schedule = true
+  }
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,244 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+)
+
+func resourceAwsMacie2CustomDataIdentifier() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMacie2CustomDataIdentifierCreate,
+		Read:   resourceAwsMacie2CustomDataIdentifierRead,
+		Update: resourceAwsMacie2CustomDataIdentifierUpdate,
+		Delete: resourceAwsMacie2CustomDataIdentifierDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"ignore_words": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 10,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(4, 90),
+				},
+			},
+			"keywords": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 50,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(3, 90),
+				},
+			},
+			"maximum_match_distance": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 300),
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validation.StringLenBetween(1, 128),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validation.StringLenBetween(1, 128-resource.UniqueIDSuffixLength),
+			},
+			"regex": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsMacie2CustomDataIdentifierCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
+
+	input := &macie2.CreateCustomDataIdentifierInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+		Regex:       aws.String(d.Get("regex").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ignore_words"); ok && v.(*schema.Set).Len() > 0 {
+		input.IgnoreWords = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("keywords"); ok && v.(*schema.Set).Len() > 0 {
+		input.Keywords = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("maximum_match_distance"); ok {
+		input.MaximumMatchDistance = aws.Int64(int64(v.(int)))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().Macie2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Macie Custom Data Identifier: %s", input)
+	output, err := conn.CreateCustomDataIdentifier(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Macie Custom Data Identifier (%s): %w", name, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error creating Macie Custom Data Identifier (%s): empty response", name)
+	}
+
+	d.SetId(aws.StringValue(output.CustomDataIdentifierId))
+
+	return resourceAwsMacie2CustomDataIdentifierRead(d, meta)
+}
+
+func resourceAwsMacie2CustomDataIdentifierRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := conn.GetCustomDataIdentifier(&macie2.GetCustomDataIdentifierInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) || isMacie2NotEnabledError(err)) {
+		log.Printf("[WARN] Macie Custom Data Identifier (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Custom Data Identifier (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading Macie Custom Data Identifier (%s): empty response", d.Id())
+	}
+
+	if aws.BoolValue(output.Deleted) {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Macie Custom Data Identifier (%s): deleted after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Macie Custom Data Identifier (%s) deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("created_at", aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("description", output.Description)
+
+	if err := d.Set("ignore_words", flattenStringSet(output.IgnoreWords)); err != nil {
+		return fmt.Errorf("error setting ignore_words: %w", err)
+	}
+
+	if err := d.Set("keywords", flattenStringSet(output.Keywords)); err != nil {
+		return fmt.Errorf("error setting keywords: %w", err)
+	}
+
+	d.Set("maximum_match_distance", output.MaximumMatchDistance)
+	d.Set("name", output.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(output.Name)))
+	d.Set("regex", output.Regex)
+
+	tags := keyvaluetags.Macie2KeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2CustomDataIdentifierUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Macie2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Macie Custom Data Identifier (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMacie2CustomDataIdentifierRead(d, meta)
+}
+
+func resourceAwsMacie2CustomDataIdentifierDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	log.Printf("[DEBUG] Deleting Macie Custom Data Identifier: %s", d.Id())
+	_, err := conn.DeleteCustomDataIdentifier(&macie2.DeleteCustomDataIdentifierInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) || isMacie2NotEnabledError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Macie Custom Data Identifier (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
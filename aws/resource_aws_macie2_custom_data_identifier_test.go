@@ -0,0 +1,318 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_macie2_custom_data_identifier", &resource.Sweeper{
+		Name: "aws_macie2_custom_data_identifier",
+		F:    testSweepMacie2CustomDataIdentifiers,
+	})
+}
+
+func testSweepMacie2CustomDataIdentifiers(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).macie2conn
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListCustomDataIdentifiersPages(&macie2.ListCustomDataIdentifiersInput{}, func(page *macie2.ListCustomDataIdentifiersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, customDataIdentifier := range page.Items {
+			if customDataIdentifier == nil {
+				continue
+			}
+
+			id := aws.StringValue(customDataIdentifier.Id)
+			r := resourceAwsMacie2CustomDataIdentifier()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			log.Printf("[INFO] Deleting Macie Custom Data Identifier: %s", id)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting Macie Custom Data Identifier (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) || isMacie2NotEnabledError(err) {
+		log.Printf("[WARN] Skipping Macie Custom Data Identifier sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Macie Custom Data Identifiers: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func testAccAwsMacie2CustomDataIdentifier_basic(t *testing.T) {
+	var output macie2.GetCustomDataIdentifierOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_custom_data_identifier.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &output),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "macie2", regexp.MustCompile(`custom-data-identifier/.+`)),
+					testAccCheckResourceAttrRfc3339(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "ignore_words.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "keywords.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "regex", "[0-9]{3}-[0-9]{2}-[0-9]{4}"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2CustomDataIdentifier_disappears(t *testing.T) {
+	var output macie2.GetCustomDataIdentifierOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_custom_data_identifier.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &output),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2CustomDataIdentifier(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2CustomDataIdentifier_NamePrefix(t *testing.T) {
+	var output macie2.GetCustomDataIdentifierOutput
+	resourceName := "aws_macie2_custom_data_identifier.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigNamePrefix("tf-acc-test-prefix-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &output),
+					naming.TestCheckResourceAttrNameFromPrefix(resourceName, "name", "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttr(resourceName, "name_prefix", "tf-acc-test-prefix-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2CustomDataIdentifier_Tags(t *testing.T) {
+	var output macie2.GetCustomDataIdentifierOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_custom_data_identifier.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2CustomDataIdentifierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsMacie2CustomDataIdentifierConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2CustomDataIdentifierDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_custom_data_identifier" {
+			continue
+		}
+
+		output, err := conn.GetCustomDataIdentifier(&macie2.GetCustomDataIdentifierInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) || isMacie2NotEnabledError(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.BoolValue(output.Deleted) {
+			continue
+		}
+
+		return fmt.Errorf("Macie Custom Data Identifier (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName string, v *macie2.GetCustomDataIdentifierOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		output, err := conn.GetCustomDataIdentifier(&macie2.GetCustomDataIdentifierInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.BoolValue(output.Deleted) {
+			return fmt.Errorf("Macie Custom Data Identifier (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name  = %[1]q
+  regex = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName)
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigNamePrefix(namePrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name_prefix = %[1]q
+  regex       = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+  depends_on = [aws_macie2_account.test]
+}
+`, namePrefix)
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name  = %[1]q
+  regex = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAwsMacie2CustomDataIdentifierConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name  = %[1]q
+  regex = "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,360 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
+)
+
+func resourceAwsMacie2FindingsFilter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMacie2FindingsFilterCreate,
+		Read:   resourceAwsMacie2FindingsFilterRead,
+		Update: resourceAwsMacie2FindingsFilterUpdate,
+		Delete: resourceAwsMacie2FindingsFilterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(macie2.FindingsFilterAction_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"finding_criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"criterion": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"eq": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"field": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"gt": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"gte": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"lt": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"lte": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"neq": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validation.StringLenBetween(3, 64),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validation.StringLenBetween(3, 64-resource.UniqueIDSuffixLength),
+			},
+			"position": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsMacie2FindingsFilterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
+
+	input := &macie2.CreateFindingsFilterInput{
+		Action:      aws.String(d.Get("action").(string)),
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("finding_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.FindingCriteria = expandMacie2FindingCriteria(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("position"); ok {
+		input.Position = aws.Int64(int64(v.(int)))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().Macie2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Macie Findings Filter: %s", input)
+	output, err := conn.CreateFindingsFilter(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Macie Findings Filter (%s): %w", name, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error creating Macie Findings Filter (%s): empty response", name)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceAwsMacie2FindingsFilterRead(d, meta)
+}
+
+func resourceAwsMacie2FindingsFilterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := conn.GetFindingsFilter(&macie2.GetFindingsFilterInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) || isMacie2NotEnabledError(err)) {
+		log.Printf("[WARN] Macie Findings Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Macie Findings Filter (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading Macie Findings Filter (%s): empty response", d.Id())
+	}
+
+	d.Set("action", output.Action)
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+
+	if err := d.Set("finding_criteria", flattenMacie2FindingCriteria(output.FindingCriteria)); err != nil {
+		return fmt.Errorf("error setting finding_criteria: %w", err)
+	}
+
+	d.Set("name", output.Name)
+	d.Set("name_prefix", naming.NamePrefixFromName(aws.StringValue(output.Name)))
+	d.Set("position", output.Position)
+
+	tags := keyvaluetags.Macie2KeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMacie2FindingsFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	if d.HasChanges("action", "description", "finding_criteria", "name", "position") {
+		input := &macie2.UpdateFindingsFilterInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange("action") {
+			input.Action = aws.String(d.Get("action").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("finding_criteria") {
+			if v, ok := d.GetOk("finding_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.FindingCriteria = expandMacie2FindingCriteria(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("position") {
+			input.Position = aws.Int64(int64(d.Get("position").(int)))
+		}
+
+		log.Printf("[DEBUG] Updating Macie Findings Filter: %s", input)
+		_, err := conn.UpdateFindingsFilter(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Macie Findings Filter (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Macie2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Macie Findings Filter (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMacie2FindingsFilterRead(d, meta)
+}
+
+func resourceAwsMacie2FindingsFilterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).macie2conn
+
+	log.Printf("[DEBUG] Deleting Macie Findings Filter: %s", d.Id())
+	_, err := conn.DeleteFindingsFilter(&macie2.DeleteFindingsFilterInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) || isMacie2NotEnabledError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Macie Findings Filter (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMacie2FindingCriteria(tfMap map[string]interface{}) *macie2.FindingCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &macie2.FindingCriteria{
+		Criterion: map[string]*macie2.CriterionAdditionalProperties{},
+	}
+
+	if v, ok := tfMap["criterion"].(*schema.Set); ok {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			field := tfMap["field"].(string)
+			criterion := &macie2.CriterionAdditionalProperties{}
+
+			if v, ok := tfMap["eq"].(*schema.Set); ok && v.Len() > 0 {
+				criterion.Eq = expandStringSet(v)
+			}
+
+			if v, ok := tfMap["gt"].(int); ok && v != 0 {
+				criterion.Gt = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["gte"].(int); ok && v != 0 {
+				criterion.Gte = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["lt"].(int); ok && v != 0 {
+				criterion.Lt = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["lte"].(int); ok && v != 0 {
+				criterion.Lte = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["neq"].(*schema.Set); ok && v.Len() > 0 {
+				criterion.Neq = expandStringSet(v)
+			}
+
+			apiObject.Criterion[field] = criterion
+		}
+	}
+
+	return apiObject
+}
+
+func flattenMacie2FindingCriteria(apiObject *macie2.FindingCriteria) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	var criteria []interface{}
+
+	for field, criterion := range apiObject.Criterion {
+		if criterion == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"eq":    flattenStringSet(criterion.Eq),
+			"field": field,
+			"gt":    aws.Int64Value(criterion.Gt),
+			"gte":   aws.Int64Value(criterion.Gte),
+			"lt":    aws.Int64Value(criterion.Lt),
+			"lte":   aws.Int64Value(criterion.Lte),
+			"neq":   flattenStringSet(criterion.Neq),
+		}
+
+		criteria = append(criteria, tfMap)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"criterion": criteria,
+		},
+	}
+}
@@ -0,0 +1,332 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_macie2_findings_filter", &resource.Sweeper{
+		Name: "aws_macie2_findings_filter",
+		F:    testSweepMacie2FindingsFilters,
+	})
+}
+
+func testSweepMacie2FindingsFilters(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).macie2conn
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListFindingsFiltersPages(&macie2.ListFindingsFiltersInput{}, func(page *macie2.ListFindingsFiltersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, findingsFilter := range page.FindingsFilterListItems {
+			if findingsFilter == nil {
+				continue
+			}
+
+			id := aws.StringValue(findingsFilter.Id)
+			r := resourceAwsMacie2FindingsFilter()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			log.Printf("[INFO] Deleting Macie Findings Filter: %s", id)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting Macie Findings Filter (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) || isMacie2NotEnabledError(err) {
+		log.Printf("[WARN] Skipping Macie Findings Filter sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Macie Findings Filters: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func testAccAwsMacie2FindingsFilter_basic(t *testing.T) {
+	var output macie2.GetFindingsFilterOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_findings_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2FindingsFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2FindingsFilterConfig(rName, macie2.FindingsFilterActionArchive, "Policy:IAMUser/S3BlockPublicAccessDisabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2FindingsFilterExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "action", macie2.FindingsFilterActionArchive),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "macie2", regexp.MustCompile(`findings-filter/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "finding_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "finding_criteria.0.criterion.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "finding_criteria.0.criterion.*", map[string]string{
+						"field": "type",
+						"eq.#":  "1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "position"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2FindingsFilter_disappears(t *testing.T) {
+	var output macie2.GetFindingsFilterOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_findings_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2FindingsFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2FindingsFilterConfig(rName, macie2.FindingsFilterActionArchive, "Policy:IAMUser/S3BlockPublicAccessDisabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2FindingsFilterExists(resourceName, &output),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2FindingsFilter(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2FindingsFilter_Update(t *testing.T) {
+	var output macie2.GetFindingsFilterOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_findings_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2FindingsFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2FindingsFilterConfig(rName, macie2.FindingsFilterActionArchive, "Policy:IAMUser/S3BlockPublicAccessDisabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2FindingsFilterExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "action", macie2.FindingsFilterActionArchive),
+				),
+			},
+			{
+				Config: testAccAwsMacie2FindingsFilterConfig(rName, macie2.FindingsFilterActionNoop, "Policy:IAMUser/S3BucketPublic"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2FindingsFilterExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "action", macie2.FindingsFilterActionNoop),
+					resource.TestCheckTypeSetElemAttr(resourceName, "finding_criteria.0.criterion.*.eq.*", "Policy:IAMUser/S3BucketPublic"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2FindingsFilter_Tags(t *testing.T) {
+	var output macie2.GetFindingsFilterOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_macie2_findings_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2FindingsFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2FindingsFilterConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2FindingsFilterExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsMacie2FindingsFilterConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2FindingsFilterExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsMacie2FindingsFilterConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2FindingsFilterExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2FindingsFilterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_findings_filter" {
+			continue
+		}
+
+		_, err := conn.GetFindingsFilter(&macie2.GetFindingsFilterInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) || isMacie2NotEnabledError(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Macie Findings Filter (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMacie2FindingsFilterExists(resourceName string, v *macie2.GetFindingsFilterOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		output, err := conn.GetFindingsFilter(&macie2.GetFindingsFilterInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Macie Findings Filter (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAwsMacie2FindingsFilterConfig(rName, action, findingType string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_findings_filter" "test" {
+  name   = %[1]q
+  action = %[2]q
+
+  finding_criteria {
+    criterion {
+      field = "type"
+      eq    = [%[3]q]
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, action, findingType)
+}
+
+func testAccAwsMacie2FindingsFilterConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_findings_filter" "test" {
+  name   = %[1]q
+  action = "ARCHIVE"
+
+  finding_criteria {
+    criterion {
+      field = "type"
+      eq    = ["Policy:IAMUser/S3BlockPublicAccessDisabled"]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAwsMacie2FindingsFilterConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_findings_filter" "test" {
+  name   = %[1]q
+  action = "ARCHIVE"
+
+  finding_criteria {
+    criterion {
+      field = "type"
+      eq    = ["Policy:IAMUser/S3BlockPublicAccessDisabled"]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,216 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const testAccAwsMacie2MemberEmail = "example@example.com"
+
+func testAccAwsMacie2Member_basic(t *testing.T) {
+	var output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2MemberConfig("111111111111", testAccAwsMacie2MemberEmail),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "account_id", "111111111111"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "email", testAccAwsMacie2MemberEmail),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusCreated),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"invitation_disable_email_notification", "invitation_message"},
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_disappears(t *testing.T) {
+	var output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2MemberConfig("111111111111", testAccAwsMacie2MemberEmail),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &output),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMacie2Member(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAwsMacie2Member_Tags(t *testing.T) {
+	var output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(macie2.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMacie2MemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsMacie2MemberConfigTags1("111111111111", testAccAwsMacie2MemberEmail, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"invitation_disable_email_notification", "invitation_message"},
+			},
+			{
+				Config: testAccAwsMacie2MemberConfigTags2("111111111111", testAccAwsMacie2MemberEmail, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsMacie2MemberConfigTags1("111111111111", testAccAwsMacie2MemberEmail, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMacie2MemberExists(resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMacie2MemberDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_member" {
+			continue
+		}
+
+		output, err := conn.GetMember(&macie2.GetMemberInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) || isMacie2NotEnabledError(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.StringValue(output.RelationshipStatus) == macie2.RelationshipStatusRemoved {
+			continue
+		}
+
+		return fmt.Errorf("Macie Member (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMacie2MemberExists(resourceName string, v *macie2.GetMemberOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+
+		output, err := conn.GetMember(&macie2.GetMemberInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Macie Member (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAwsMacie2MemberConfig(accountID, email string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_member" "test" {
+  account_id = %[1]q
+  email      = %[2]q
+
+  depends_on = [aws_macie2_account.test]
+}
+`, accountID, email)
+}
+
+func testAccAwsMacie2MemberConfigTags1(accountID, email, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_member" "test" {
+  account_id = %[1]q
+  email      = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, accountID, email, tagKey1, tagValue1)
+}
+
+func testAccAwsMacie2MemberConfigTags2(accountID, email, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_member" "test" {
+  account_id = %[1]q
+  email      = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, accountID, email, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,56 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestAccAWSMacie2_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Account": {
+			"basic":                      testAccAwsMacie2Account_basic,
+			"disappears":                 testAccAwsMacie2Account_disappears,
+			"FindingPublishingFrequency": testAccAwsMacie2Account_FindingPublishingFrequency,
+			"Status":                     testAccAwsMacie2Account_Status,
+		},
+		"ClassificationJob": {
+			"basic":      testAccAwsMacie2ClassificationJob_basic,
+			"disappears": testAccAwsMacie2ClassificationJob_disappears,
+			"JobStatus":  testAccAwsMacie2ClassificationJob_JobStatus,
+			"Scheduled":  testAccAwsMacie2ClassificationJob_Scheduled,
+			"Tags":       testAccAwsMacie2ClassificationJob_Tags,
+		},
+		"CustomDataIdentifier": {
+			"basic":      testAccAwsMacie2CustomDataIdentifier_basic,
+			"disappears": testAccAwsMacie2CustomDataIdentifier_disappears,
+			"NamePrefix": testAccAwsMacie2CustomDataIdentifier_NamePrefix,
+			"Tags":       testAccAwsMacie2CustomDataIdentifier_Tags,
+		},
+		"FindingsFilter": {
+			"basic":      testAccAwsMacie2FindingsFilter_basic,
+			"disappears": testAccAwsMacie2FindingsFilter_disappears,
+			"Update":     testAccAwsMacie2FindingsFilter_Update,
+			"Tags":       testAccAwsMacie2FindingsFilter_Tags,
+		},
+		"Member": {
+			"basic":      testAccAwsMacie2Member_basic,
+			"disappears": testAccAwsMacie2Member_disappears,
+			"Tags":       testAccAwsMacie2Member_Tags,
+		},
+		"OrganizationAdminAccount": {
+			"basic":      testAccAwsMacie2OrganizationAdminAccount_basic,
+			"disappears": testAccAwsMacie2OrganizationAdminAccount_disappears,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
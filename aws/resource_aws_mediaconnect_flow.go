@@ -0,0 +1,1055 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mediaconnect/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mediaconnect/waiter"
+)
+
+func resourceAwsMediaConnectFlow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaConnectFlowCreate,
+		Read:   resourceAwsMediaConnectFlowRead,
+		Update: resourceAwsMediaConnectFlowUpdate,
+		Delete: resourceAwsMediaConnectFlowDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"egress_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entitlement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_transfer_subscriber_fee_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"encryption": mediaConnectEncryptionSchema(),
+						"entitlement_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"entitlement_status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.EntitlementStatus_Values(), false),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subscribers": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAwsAccountId,
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr_allow_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateIpv4CIDRNetworkAddress,
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"destination": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"encryption": mediaConnectEncryptionSchema(),
+						"max_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"output_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.Protocol_Values(), false),
+						},
+						"remote_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"smoothing_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"stream_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"decryption": mediaConnectEncryptionSchema(),
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"entitlement_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"ingest_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ingest_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"max_bitrate": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"max_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(mediaconnect.Protocol_Values(), false),
+						},
+						"source_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stream_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"whitelist_cidr": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateIpv4CIDRNetworkAddress,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func mediaConnectEncryptionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"algorithm": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(mediaconnect.Algorithm_Values(), false),
+				},
+				"constant_initialization_vector": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"device_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"key_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      mediaconnect.KeyTypeStaticKey,
+					ValidateFunc: validation.StringInSlice(mediaconnect.KeyType_Values(), false),
+				},
+				"region": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"resource_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+				},
+				"secret_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateArn,
+				},
+				"url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMediaConnectFlowCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediaconnectconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &mediaconnect.CreateFlowInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("entitlement"); ok && len(v.([]interface{})) > 0 {
+		input.Entitlements = expandMediaConnectGrantEntitlementRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("output"); ok && len(v.([]interface{})) > 0 {
+		input.Outputs = expandMediaConnectAddOutputRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("source"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Source = expandMediaConnectSetSourceRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MediaConnect Flow: %s", input)
+	output, err := conn.CreateFlow(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaConnect Flow (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Flow.FlowArn))
+
+	if _, err := waiter.FlowStandby(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaConnect Flow (%s) creation: %w", d.Id(), err)
+	}
+
+	// CreateFlow does not accept tags.
+	if len(tags) > 0 {
+		if err := keyvaluetags.MediaconnectUpdateTags(conn, d.Id(), nil, tags.IgnoreAws()); err != nil {
+			return fmt.Errorf("error adding MediaConnect Flow (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaConnectFlowRead(d, meta)
+}
+
+func resourceAwsMediaConnectFlowRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediaconnectconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	flow, err := finder.FlowByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		log.Printf("[WARN] MediaConnect Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaConnect Flow (%s): %w", d.Id(), err)
+	}
+
+	if flow == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading MediaConnect Flow (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] MediaConnect Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", flow.FlowArn)
+	d.Set("availability_zone", flow.AvailabilityZone)
+	d.Set("egress_ip", flow.EgressIp)
+
+	if err := d.Set("entitlement", flattenMediaConnectEntitlements(flow.Entitlements)); err != nil {
+		return fmt.Errorf("error setting entitlement: %w", err)
+	}
+
+	d.Set("name", flow.Name)
+
+	if err := d.Set("output", flattenMediaConnectOutputs(flow.Outputs)); err != nil {
+		return fmt.Errorf("error setting output: %w", err)
+	}
+
+	if flow.Source != nil {
+		if err := d.Set("source", []interface{}{flattenMediaConnectSource(flow.Source)}); err != nil {
+			return fmt.Errorf("error setting source: %w", err)
+		}
+	} else {
+		d.Set("source", nil)
+	}
+
+	d.Set("status", flow.Status)
+
+	tags, err := keyvaluetags.MediaconnectListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaConnect Flow (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaConnectFlowUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediaconnectconn
+
+	if d.HasChange("source") {
+		o, n := d.GetChange("source")
+		oldTfMap := o.([]interface{})[0].(map[string]interface{})
+		tfMap := n.([]interface{})[0].(map[string]interface{})
+		input := &mediaconnect.UpdateFlowSourceInput{
+			FlowArn:   aws.String(d.Id()),
+			SourceArn: aws.String(oldTfMap["source_arn"].(string)),
+		}
+
+		if v, ok := tfMap["decryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			input.Decryption = expandMediaConnectUpdateEncryption(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			input.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["entitlement_arn"].(string); ok && v != "" {
+			input.EntitlementArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["ingest_port"].(int); ok && v != 0 {
+			input.IngestPort = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["max_bitrate"].(int); ok && v != 0 {
+			input.MaxBitrate = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+			input.MaxLatency = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["protocol"].(string); ok && v != "" {
+			input.Protocol = aws.String(v)
+		}
+
+		if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+			input.StreamId = aws.String(v)
+		}
+
+		if v, ok := tfMap["whitelist_cidr"].(string); ok && v != "" {
+			input.WhitelistCidr = aws.String(v)
+		}
+
+		log.Printf("[DEBUG] Updating MediaConnect Flow source: %s", input)
+		_, err := conn.UpdateFlowSource(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaConnect Flow (%s) source: %w", d.Id(), err)
+		}
+
+		if _, err := waiter.FlowUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MediaConnect Flow (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("output") {
+		if err := resourceAwsMediaConnectFlowUpdateOutputs(conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("entitlement") {
+		if err := resourceAwsMediaConnectFlowUpdateEntitlements(conn, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MediaconnectUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating MediaConnect Flow (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaConnectFlowRead(d, meta)
+}
+
+func resourceAwsMediaConnectFlowDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediaconnectconn
+
+	flow, err := finder.FlowByARN(conn, d.Id())
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaConnect Flow (%s): %w", d.Id(), err)
+	}
+
+	// An active flow must be stopped before it can be deleted.
+	if flow != nil && aws.StringValue(flow.Status) == mediaconnect.StatusActive {
+		log.Printf("[DEBUG] Stopping MediaConnect Flow: %s", d.Id())
+		_, err := conn.StopFlow(&mediaconnect.StopFlowInput{
+			FlowArn: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error stopping MediaConnect Flow (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.FlowStandby(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MediaConnect Flow (%s) stop: %w", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConnect Flow: %s", d.Id())
+	_, err = conn.DeleteFlow(&mediaconnect.DeleteFlowInput{
+		FlowArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaConnect Flow (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.FlowDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaConnect Flow (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsMediaConnectFlowUpdateOutputs reconciles the flow outputs by name,
+// removing, adding and updating outputs as required.
+func resourceAwsMediaConnectFlowUpdateOutputs(conn *mediaconnect.MediaConnect, d *schema.ResourceData) error {
+	o, n := d.GetChange("output")
+	oldOutputs := mediaConnectFlowBlocksByName(o.([]interface{}))
+	newOutputs := mediaConnectFlowBlocksByName(n.([]interface{}))
+
+	for name, tfMap := range oldOutputs {
+		if _, ok := newOutputs[name]; ok {
+			continue
+		}
+
+		outputArn := tfMap["output_arn"].(string)
+
+		log.Printf("[DEBUG] Removing MediaConnect Flow (%s) output: %s", d.Id(), outputArn)
+		_, err := conn.RemoveFlowOutput(&mediaconnect.RemoveFlowOutputInput{
+			FlowArn:   aws.String(d.Id()),
+			OutputArn: aws.String(outputArn),
+		})
+
+		if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error removing MediaConnect Flow (%s) output (%s): %w", d.Id(), outputArn, err)
+		}
+	}
+
+	var addOutputs []interface{}
+
+	for name, tfMap := range newOutputs {
+		oldTfMap, ok := oldOutputs[name]
+
+		if !ok {
+			addOutputs = append(addOutputs, tfMap)
+			continue
+		}
+
+		input := expandMediaConnectUpdateFlowOutputInput(tfMap)
+		input.FlowArn = aws.String(d.Id())
+		input.OutputArn = aws.String(oldTfMap["output_arn"].(string))
+
+		log.Printf("[DEBUG] Updating MediaConnect Flow output: %s", input)
+		_, err := conn.UpdateFlowOutput(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaConnect Flow (%s) output (%s): %w", d.Id(), name, err)
+		}
+	}
+
+	if len(addOutputs) > 0 {
+		input := &mediaconnect.AddFlowOutputsInput{
+			FlowArn: aws.String(d.Id()),
+			Outputs: expandMediaConnectAddOutputRequests(addOutputs),
+		}
+
+		log.Printf("[DEBUG] Adding MediaConnect Flow outputs: %s", input)
+		_, err := conn.AddFlowOutputs(input)
+
+		if err != nil {
+			return fmt.Errorf("error adding MediaConnect Flow (%s) outputs: %w", d.Id(), err)
+		}
+	}
+
+	if _, err := waiter.FlowUpdated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaConnect Flow (%s) update: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsMediaConnectFlowUpdateEntitlements reconciles the flow entitlements by name.
+// Entitlements whose subscriber fee changed are revoked and granted again as the
+// fee cannot be updated in place.
+func resourceAwsMediaConnectFlowUpdateEntitlements(conn *mediaconnect.MediaConnect, d *schema.ResourceData) error {
+	o, n := d.GetChange("entitlement")
+	oldEntitlements := mediaConnectFlowBlocksByName(o.([]interface{}))
+	newEntitlements := mediaConnectFlowBlocksByName(n.([]interface{}))
+
+	var grantEntitlements []interface{}
+
+	for name, tfMap := range oldEntitlements {
+		newTfMap, ok := newEntitlements[name]
+
+		if ok && newTfMap["data_transfer_subscriber_fee_percent"] == tfMap["data_transfer_subscriber_fee_percent"] {
+			continue
+		}
+
+		entitlementArn := tfMap["entitlement_arn"].(string)
+
+		log.Printf("[DEBUG] Revoking MediaConnect Flow (%s) entitlement: %s", d.Id(), entitlementArn)
+		_, err := conn.RevokeFlowEntitlement(&mediaconnect.RevokeFlowEntitlementInput{
+			EntitlementArn: aws.String(entitlementArn),
+			FlowArn:        aws.String(d.Id()),
+		})
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+			return fmt.Errorf("error revoking MediaConnect Flow (%s) entitlement (%s): %w", d.Id(), entitlementArn, err)
+		}
+
+		if ok {
+			grantEntitlements = append(grantEntitlements, newTfMap)
+		}
+	}
+
+	for name, tfMap := range newEntitlements {
+		oldTfMap, ok := oldEntitlements[name]
+
+		if !ok {
+			grantEntitlements = append(grantEntitlements, tfMap)
+			continue
+		}
+
+		if tfMap["data_transfer_subscriber_fee_percent"] != oldTfMap["data_transfer_subscriber_fee_percent"] {
+			continue
+		}
+
+		input := &mediaconnect.UpdateFlowEntitlementInput{
+			Description:    aws.String(tfMap["description"].(string)),
+			EntitlementArn: aws.String(oldTfMap["entitlement_arn"].(string)),
+			FlowArn:        aws.String(d.Id()),
+			Subscribers:    expandStringSet(tfMap["subscribers"].(*schema.Set)),
+		}
+
+		if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			input.Encryption = expandMediaConnectUpdateEncryption(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["entitlement_status"].(string); ok && v != "" {
+			input.EntitlementStatus = aws.String(v)
+		}
+
+		log.Printf("[DEBUG] Updating MediaConnect Flow entitlement: %s", input)
+		_, err := conn.UpdateFlowEntitlement(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaConnect Flow (%s) entitlement (%s): %w", d.Id(), name, err)
+		}
+	}
+
+	if len(grantEntitlements) > 0 {
+		input := &mediaconnect.GrantFlowEntitlementsInput{
+			Entitlements: expandMediaConnectGrantEntitlementRequests(grantEntitlements),
+			FlowArn:      aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Granting MediaConnect Flow entitlements: %s", input)
+		_, err := conn.GrantFlowEntitlements(input)
+
+		if err != nil {
+			return fmt.Errorf("error granting MediaConnect Flow (%s) entitlements: %w", d.Id(), err)
+		}
+	}
+
+	if _, err := waiter.FlowUpdated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaConnect Flow (%s) update: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func mediaConnectFlowBlocksByName(tfList []interface{}) map[string]map[string]interface{} {
+	m := make(map[string]map[string]interface{}, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		m[tfMap["name"].(string)] = tfMap
+	}
+
+	return m
+}
+
+func expandMediaConnectEncryption(tfMap map[string]interface{}) *mediaconnect.Encryption {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconnect.Encryption{
+		Algorithm: aws.String(tfMap["algorithm"].(string)),
+		RoleArn:   aws.String(tfMap["role_arn"].(string)),
+	}
+
+	if v, ok := tfMap["constant_initialization_vector"].(string); ok && v != "" {
+		apiObject.ConstantInitializationVector = aws.String(v)
+	}
+
+	if v, ok := tfMap["device_id"].(string); ok && v != "" {
+		apiObject.DeviceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_type"].(string); ok && v != "" {
+		apiObject.KeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_id"].(string); ok && v != "" {
+		apiObject.ResourceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["secret_arn"].(string); ok && v != "" {
+		apiObject.SecretArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["url"].(string); ok && v != "" {
+		apiObject.Url = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMediaConnectUpdateEncryption(tfMap map[string]interface{}) *mediaconnect.UpdateEncryption {
+	apiObject := expandMediaConnectEncryption(tfMap)
+
+	if apiObject == nil {
+		return nil
+	}
+
+	return &mediaconnect.UpdateEncryption{
+		Algorithm:                    apiObject.Algorithm,
+		ConstantInitializationVector: apiObject.ConstantInitializationVector,
+		DeviceId:                     apiObject.DeviceId,
+		KeyType:                      apiObject.KeyType,
+		Region:                       apiObject.Region,
+		ResourceId:                   apiObject.ResourceId,
+		RoleArn:                      apiObject.RoleArn,
+		SecretArn:                    apiObject.SecretArn,
+		Url:                          apiObject.Url,
+	}
+}
+
+func expandMediaConnectGrantEntitlementRequests(tfList []interface{}) []*mediaconnect.GrantEntitlementRequest {
+	var apiObjects []*mediaconnect.GrantEntitlementRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconnect.GrantEntitlementRequest{
+			Description: aws.String(tfMap["description"].(string)),
+			Name:        aws.String(tfMap["name"].(string)),
+			Subscribers: expandStringSet(tfMap["subscribers"].(*schema.Set)),
+		}
+
+		if v, ok := tfMap["data_transfer_subscriber_fee_percent"].(int); ok && v != 0 {
+			apiObject.DataTransferSubscriberFeePercent = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Encryption = expandMediaConnectEncryption(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["entitlement_status"].(string); ok && v != "" {
+			apiObject.EntitlementStatus = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMediaConnectAddOutputRequests(tfList []interface{}) []*mediaconnect.AddOutputRequest {
+	var apiObjects []*mediaconnect.AddOutputRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediaconnect.AddOutputRequest{
+			Name:     aws.String(tfMap["name"].(string)),
+			Protocol: aws.String(tfMap["protocol"].(string)),
+		}
+
+		if v, ok := tfMap["cidr_allow_list"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.CidrAllowList = expandStringSet(v)
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["destination"].(string); ok && v != "" {
+			apiObject.Destination = aws.String(v)
+		}
+
+		if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Encryption = expandMediaConnectEncryption(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+			apiObject.MaxLatency = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["port"].(int); ok && v != 0 {
+			apiObject.Port = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["remote_id"].(string); ok && v != "" {
+			apiObject.RemoteId = aws.String(v)
+		}
+
+		if v, ok := tfMap["smoothing_latency"].(int); ok && v != 0 {
+			apiObject.SmoothingLatency = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+			apiObject.StreamId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMediaConnectUpdateFlowOutputInput(tfMap map[string]interface{}) *mediaconnect.UpdateFlowOutputInput {
+	apiObject := &mediaconnect.UpdateFlowOutputInput{
+		Protocol: aws.String(tfMap["protocol"].(string)),
+	}
+
+	if v, ok := tfMap["cidr_allow_list"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CidrAllowList = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination"].(string); ok && v != "" {
+		apiObject.Destination = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Encryption = expandMediaConnectUpdateEncryption(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["port"].(int); ok && v != 0 {
+		apiObject.Port = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["remote_id"].(string); ok && v != "" {
+		apiObject.RemoteId = aws.String(v)
+	}
+
+	if v, ok := tfMap["smoothing_latency"].(int); ok && v != 0 {
+		apiObject.SmoothingLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMediaConnectSetSourceRequest(tfMap map[string]interface{}) *mediaconnect.SetSourceRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediaconnect.SetSourceRequest{
+		Name: aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["decryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Decryption = expandMediaConnectEncryption(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["entitlement_arn"].(string); ok && v != "" {
+		apiObject.EntitlementArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["ingest_port"].(int); ok && v != 0 {
+		apiObject.IngestPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_bitrate"].(int); ok && v != 0 {
+		apiObject.MaxBitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	if v, ok := tfMap["whitelist_cidr"].(string); ok && v != "" {
+		apiObject.WhitelistCidr = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenMediaConnectEncryption(apiObject *mediaconnect.Encryption) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"algorithm":                      aws.StringValue(apiObject.Algorithm),
+		"constant_initialization_vector": aws.StringValue(apiObject.ConstantInitializationVector),
+		"device_id":                      aws.StringValue(apiObject.DeviceId),
+		"key_type":                       aws.StringValue(apiObject.KeyType),
+		"region":                         aws.StringValue(apiObject.Region),
+		"resource_id":                    aws.StringValue(apiObject.ResourceId),
+		"role_arn":                       aws.StringValue(apiObject.RoleArn),
+		"secret_arn":                     aws.StringValue(apiObject.SecretArn),
+		"url":                            aws.StringValue(apiObject.Url),
+	}}
+}
+
+func flattenMediaConnectEntitlements(apiObjects []*mediaconnect.Entitlement) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"data_transfer_subscriber_fee_percent": aws.Int64Value(apiObject.DataTransferSubscriberFeePercent),
+			"description":                          aws.StringValue(apiObject.Description),
+			"encryption":                           flattenMediaConnectEncryption(apiObject.Encryption),
+			"entitlement_arn":                      aws.StringValue(apiObject.EntitlementArn),
+			"entitlement_status":                   aws.StringValue(apiObject.EntitlementStatus),
+			"name":                                 aws.StringValue(apiObject.Name),
+			"subscribers":                          aws.StringValueSlice(apiObject.Subscribers),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMediaConnectOutputs(apiObjects []*mediaconnect.Output) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		// Outputs created by MediaConnect on behalf of a MediaLive input are
+		// managed through the aws_medialive_input resource.
+		if apiObject.MediaLiveInputArn != nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"destination": aws.StringValue(apiObject.Destination),
+			"encryption":  flattenMediaConnectEncryption(apiObject.Encryption),
+			"name":        aws.StringValue(apiObject.Name),
+			"output_arn":  aws.StringValue(apiObject.OutputArn),
+			"port":        aws.Int64Value(apiObject.Port),
+		}
+
+		if v := apiObject.Transport; v != nil {
+			tfMap["cidr_allow_list"] = aws.StringValueSlice(v.CidrAllowList)
+			tfMap["max_latency"] = aws.Int64Value(v.MaxLatency)
+			tfMap["protocol"] = aws.StringValue(v.Protocol)
+			tfMap["remote_id"] = aws.StringValue(v.RemoteId)
+			tfMap["smoothing_latency"] = aws.Int64Value(v.SmoothingLatency)
+			tfMap["stream_id"] = aws.StringValue(v.StreamId)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMediaConnectSource(apiObject *mediaconnect.Source) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"decryption":      flattenMediaConnectEncryption(apiObject.Decryption),
+		"description":     aws.StringValue(apiObject.Description),
+		"entitlement_arn": aws.StringValue(apiObject.EntitlementArn),
+		"ingest_ip":       aws.StringValue(apiObject.IngestIp),
+		"ingest_port":     aws.Int64Value(apiObject.IngestPort),
+		"name":            aws.StringValue(apiObject.Name),
+		"source_arn":      aws.StringValue(apiObject.SourceArn),
+		"whitelist_cidr":  aws.StringValue(apiObject.WhitelistCidr),
+	}
+
+	if v := apiObject.Transport; v != nil {
+		tfMap["max_bitrate"] = aws.Int64Value(v.MaxBitrate)
+		tfMap["max_latency"] = aws.Int64Value(v.MaxLatency)
+		tfMap["protocol"] = aws.StringValue(v.Protocol)
+		tfMap["stream_id"] = aws.StringValue(v.StreamId)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,383 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/mediaconnect/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_mediaconnect_flow", &resource.Sweeper{
+		Name: "aws_mediaconnect_flow",
+		F:    testSweepMediaConnectFlows,
+		Dependencies: []string{
+			"aws_medialive_input",
+		},
+	})
+}
+
+func testSweepMediaConnectFlows(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).mediaconnectconn
+	var sweeperErrs *multierror.Error
+	input := &mediaconnect.ListFlowsInput{}
+
+	err = conn.ListFlowsPages(input, func(page *mediaconnect.ListFlowsOutput, isLast bool) bool {
+		if page == nil {
+			return !isLast
+		}
+
+		for _, flow := range page.Flows {
+			if flow == nil {
+				continue
+			}
+
+			arn := aws.StringValue(flow.FlowArn)
+			r := resourceAwsMediaConnectFlow()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			log.Printf("[INFO] Deleting MediaConnect Flow: %s", arn)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting MediaConnect Flow (%s): %w", arn, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !isLast
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaConnect Flow sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing MediaConnect Flows: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSMediaConnectFlow_basic(t *testing.T) {
+	var v mediaconnect.Flow
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mediaconnect.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaConnectFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaConnectFlowConfig(rName, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConnectFlowExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "mediaconnect", regexp.MustCompile(fmt.Sprintf(`flow:.+:%s$`, rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "availability_zone"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.name", "source"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.protocol", mediaconnect.ProtocolRtp),
+					resource.TestCheckResourceAttr(resourceName, "source.0.whitelist_cidr", "10.0.0.0/16"),
+					resource.TestCheckResourceAttrSet(resourceName, "source.0.source_arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconnect.StatusStandby),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaConnectFlowConfig(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConnectFlowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "source.0.whitelist_cidr", "10.1.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConnectFlow_disappears(t *testing.T) {
+	var v mediaconnect.Flow
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mediaconnect.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaConnectFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaConnectFlowConfig(rName, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConnectFlowExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMediaConnectFlow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConnectFlow_OutputsAndEntitlements(t *testing.T) {
+	var v mediaconnect.Flow
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mediaconnect.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaConnectFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaConnectFlowConfigOutputsAndEntitlements(rName, 5000, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConnectFlowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.name", "entitlement"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.description", "first"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.subscribers.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "entitlement.0.entitlement_arn"),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.name", "output"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.destination", "198.51.100.10"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.port", "5000"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.protocol", mediaconnect.ProtocolRtp),
+					resource.TestCheckResourceAttrSet(resourceName, "output.0.output_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaConnectFlowConfigOutputsAndEntitlements(rName, 5002, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConnectFlowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.description", "second"),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.port", "5002"),
+				),
+			},
+			{
+				Config: testAccAWSMediaConnectFlowConfig(rName, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConnectFlowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConnectFlow_tags(t *testing.T) {
+	var v mediaconnect.Flow
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(mediaconnect.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaConnectFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaConnectFlowConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConnectFlowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaConnectFlowConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConnectFlowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMediaConnectFlowConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaConnectFlowExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMediaConnectFlowExists(resourceName string, v *mediaconnect.Flow) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaConnect Flow ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).mediaconnectconn
+
+		output, err := finder.FlowByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("MediaConnect Flow (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSMediaConnectFlowDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).mediaconnectconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mediaconnect_flow" {
+			continue
+		}
+
+		output, err := finder.FlowByARN(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, mediaconnect.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			continue
+		}
+
+		return fmt.Errorf("MediaConnect Flow (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSMediaConnectFlowConfig(rName, whitelistCidr string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source"
+    ingest_port    = 5000
+    protocol       = "rtp"
+    whitelist_cidr = %[2]q
+  }
+}
+`, rName, whitelistCidr)
+}
+
+func testAccAWSMediaConnectFlowConfigOutputsAndEntitlements(rName string, port int, description string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source"
+    ingest_port    = 5000
+    protocol       = "rtp"
+    whitelist_cidr = "10.0.0.0/16"
+  }
+
+  output {
+    name        = "output"
+    destination = "198.51.100.10"
+    port        = %[2]d
+    protocol    = "rtp"
+  }
+
+  entitlement {
+    name        = "entitlement"
+    description = %[3]q
+    subscribers = [data.aws_caller_identity.current.account_id]
+  }
+}
+`, rName, port, description)
+}
+
+func testAccAWSMediaConnectFlowConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source"
+    ingest_port    = 5000
+    protocol       = "rtp"
+    whitelist_cidr = "10.0.0.0/16"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSMediaConnectFlowConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source"
+    ingest_port    = 5000
+    protocol       = "rtp"
+    whitelist_cidr = "10.0.0.0/16"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,783 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/waiter"
+)
+
+func resourceAwsMediaLiveChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveChannelCreate,
+		Read:   resourceAwsMediaLiveChannelRead,
+		Update: resourceAwsMediaLiveChannelUpdate,
+		Delete: resourceAwsMediaLiveChannelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      medialive.ChannelClassStandard,
+				ValidateFunc: validation.StringInSlice(medialive.ChannelClass_Values(), false),
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"media_package_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"multiplex_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"multiplex_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"program_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password_param": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stream_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"username": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"encoder_settings": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressMediaLiveSettingsJsonDiffs,
+			},
+			"input_attachment": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_attachment_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"input_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"input_settings": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressMediaLiveSettingsJsonDiffs,
+						},
+					},
+				},
+			},
+			"input_specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"codec": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(medialive.InputCodec_Values(), false),
+						},
+						"maximum_bitrate": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(medialive.InputMaximumBitrate_Values(), false),
+						},
+						"resolution": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(medialive.InputResolution_Values(), false),
+						},
+					},
+				},
+			},
+			"log_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(medialive.LogLevel_Values(), false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"start_channel": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsMediaLiveChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &medialive.CreateChannelInput{
+		ChannelClass: aws.String(d.Get("channel_class").(string)),
+		Destinations: expandMediaLiveOutputDestinations(d.Get("destination").([]interface{})),
+		Name:         aws.String(name),
+	}
+
+	encoderSettings, err := expandMediaLiveEncoderSettings(d.Get("encoder_settings").(string))
+
+	if err != nil {
+		return err
+	}
+
+	input.EncoderSettings = encoderSettings
+
+	inputAttachments, err := expandMediaLiveInputAttachments(d.Get("input_attachment").([]interface{}))
+
+	if err != nil {
+		return err
+	}
+
+	input.InputAttachments = inputAttachments
+
+	if v, ok := d.GetOk("input_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InputSpecification = expandMediaLiveInputSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("log_level"); ok {
+		input.LogLevel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().MedialiveTags()
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Channel: %s", input)
+	output, err := conn.CreateChannel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Channel (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Channel.Id))
+
+	if _, err := waiter.ChannelCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) creation: %w", d.Id(), err)
+	}
+
+	if d.Get("start_channel").(bool) {
+		if err := resourceAwsMediaLiveChannelStart(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMediaLiveChannelRead(d, meta)
+}
+
+func resourceAwsMediaLiveChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.ChannelByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		log.Printf("[WARN] MediaLive Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Channel (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || aws.StringValue(output.State) == medialive.ChannelStateDeleted {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading MediaLive Channel (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] MediaLive Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("channel_class", output.ChannelClass)
+
+	if err := d.Set("destination", flattenMediaLiveOutputDestinations(output.Destinations)); err != nil {
+		return fmt.Errorf("error setting destination: %w", err)
+	}
+
+	encoderSettings, err := jsonutil.BuildJSON(output.EncoderSettings)
+
+	if err != nil {
+		return fmt.Errorf("error serializing MediaLive Channel (%s) encoder settings: %w", d.Id(), err)
+	}
+
+	d.Set("encoder_settings", string(encoderSettings))
+
+	inputAttachments, err := flattenMediaLiveInputAttachments(output.InputAttachments)
+
+	if err != nil {
+		return fmt.Errorf("error serializing MediaLive Channel (%s) input attachments: %w", d.Id(), err)
+	}
+
+	if err := d.Set("input_attachment", inputAttachments); err != nil {
+		return fmt.Errorf("error setting input_attachment: %w", err)
+	}
+
+	if output.InputSpecification != nil {
+		if err := d.Set("input_specification", []interface{}{flattenMediaLiveInputSpecification(output.InputSpecification)}); err != nil {
+			return fmt.Errorf("error setting input_specification: %w", err)
+		}
+	} else {
+		d.Set("input_specification", nil)
+	}
+
+	d.Set("log_level", output.LogLevel)
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+
+	switch aws.StringValue(output.State) {
+	case medialive.ChannelStateStarting, medialive.ChannelStateRunning, medialive.ChannelStateRecovering:
+		d.Set("start_channel", true)
+	default:
+		d.Set("start_channel", false)
+	}
+
+	tags := keyvaluetags.MedialiveKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.HasChanges("destination", "encoder_settings", "input_attachment", "input_specification", "log_level", "name", "role_arn") {
+		output, err := finder.ChannelByID(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error reading MediaLive Channel (%s): %w", d.Id(), err)
+		}
+
+		// A channel can only be updated while it is idle.
+		if output != nil && aws.StringValue(output.State) == medialive.ChannelStateRunning {
+			if err := resourceAwsMediaLiveChannelStop(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+
+		input := &medialive.UpdateChannelInput{
+			ChannelId:    aws.String(d.Id()),
+			Destinations: expandMediaLiveOutputDestinations(d.Get("destination").([]interface{})),
+			Name:         aws.String(d.Get("name").(string)),
+		}
+
+		encoderSettings, err := expandMediaLiveEncoderSettings(d.Get("encoder_settings").(string))
+
+		if err != nil {
+			return err
+		}
+
+		input.EncoderSettings = encoderSettings
+
+		inputAttachments, err := expandMediaLiveInputAttachments(d.Get("input_attachment").([]interface{}))
+
+		if err != nil {
+			return err
+		}
+
+		input.InputAttachments = inputAttachments
+
+		if v, ok := d.GetOk("input_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.InputSpecification = expandMediaLiveInputSpecification(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("log_level"); ok {
+			input.LogLevel = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("role_arn"); ok {
+			input.RoleArn = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Channel: %s", input)
+		_, err = conn.UpdateChannel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaLive Channel (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.ChannelUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MediaLive Channel (%s) update: %w", d.Id(), err)
+		}
+
+		if d.Get("start_channel").(bool) {
+			if err := resourceAwsMediaLiveChannelStart(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+	} else if d.HasChange("start_channel") {
+		if d.Get("start_channel").(bool) {
+			if err := resourceAwsMediaLiveChannelStart(conn, d.Id()); err != nil {
+				return err
+			}
+		} else {
+			if err := resourceAwsMediaLiveChannelStop(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MedialiveUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaLive Channel (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaLiveChannelRead(d, meta)
+}
+
+func resourceAwsMediaLiveChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	output, err := finder.ChannelByID(conn, d.Id())
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Channel (%s): %w", d.Id(), err)
+	}
+
+	// A running channel must be stopped before it can be deleted.
+	if output != nil && aws.StringValue(output.State) == medialive.ChannelStateRunning {
+		if err := resourceAwsMediaLiveChannelStop(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting MediaLive Channel: %s", d.Id())
+	_, err = conn.DeleteChannel(&medialive.DeleteChannelInput{
+		ChannelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Channel (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ChannelDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveChannelStart(conn *medialive.MediaLive, id string) error {
+	log.Printf("[DEBUG] Starting MediaLive Channel: %s", id)
+	_, err := conn.StartChannel(&medialive.StartChannelInput{
+		ChannelId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error starting MediaLive Channel (%s): %w", id, err)
+	}
+
+	if _, err := waiter.ChannelStarted(conn, id); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) start: %w", id, err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveChannelStop(conn *medialive.MediaLive, id string) error {
+	log.Printf("[DEBUG] Stopping MediaLive Channel: %s", id)
+	_, err := conn.StopChannel(&medialive.StopChannelInput{
+		ChannelId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error stopping MediaLive Channel (%s): %w", id, err)
+	}
+
+	if _, err := waiter.ChannelStopped(conn, id); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) stop: %w", id, err)
+	}
+
+	return nil
+}
+
+// suppressMediaLiveSettingsJsonDiffs suppresses differences when every value in
+// the configured JSON document is present in the one returned by MediaLive,
+// which fills in defaults for settings that were not specified.
+func suppressMediaLiveSettingsJsonDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	var oldDoc, newDoc interface{}
+
+	if err := json.Unmarshal([]byte(old), &oldDoc); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(new), &newDoc); err != nil {
+		return false
+	}
+
+	return mediaLiveJsonIsSubset(newDoc, oldDoc)
+}
+
+func mediaLiveJsonIsSubset(subset, superset interface{}) bool {
+	switch subset := subset.(type) {
+	case map[string]interface{}:
+		superset, ok := superset.(map[string]interface{})
+
+		if !ok {
+			return false
+		}
+
+		for k, v := range subset {
+			if !mediaLiveJsonIsSubset(v, superset[k]) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		superset, ok := superset.([]interface{})
+
+		if !ok || len(subset) != len(superset) {
+			return false
+		}
+
+		for i := range subset {
+			if !mediaLiveJsonIsSubset(subset[i], superset[i]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(subset, superset)
+	}
+}
+
+func expandMediaLiveEncoderSettings(rawSettings string) (*medialive.EncoderSettings, error) {
+	apiObject := &medialive.EncoderSettings{}
+
+	if err := jsonutil.UnmarshalJSON(apiObject, strings.NewReader(rawSettings)); err != nil {
+		return nil, fmt.Errorf("error decoding encoder_settings: %w", err)
+	}
+
+	return apiObject, nil
+}
+
+func expandMediaLiveInputAttachments(tfList []interface{}) ([]*medialive.InputAttachment, error) {
+	var apiObjects []*medialive.InputAttachment
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.InputAttachment{
+			InputAttachmentName: aws.String(tfMap["input_attachment_name"].(string)),
+			InputId:             aws.String(tfMap["input_id"].(string)),
+		}
+
+		if v, ok := tfMap["input_settings"].(string); ok && v != "" {
+			inputSettings := &medialive.InputSettings{}
+
+			if err := jsonutil.UnmarshalJSON(inputSettings, strings.NewReader(v)); err != nil {
+				return nil, fmt.Errorf("error decoding input_settings: %w", err)
+			}
+
+			apiObject.InputSettings = inputSettings
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func expandMediaLiveInputSpecification(tfMap map[string]interface{}) *medialive.InputSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &medialive.InputSpecification{
+		Codec:          aws.String(tfMap["codec"].(string)),
+		MaximumBitrate: aws.String(tfMap["maximum_bitrate"].(string)),
+		Resolution:     aws.String(tfMap["resolution"].(string)),
+	}
+}
+
+func expandMediaLiveOutputDestinations(tfList []interface{}) []*medialive.OutputDestination {
+	var apiObjects []*medialive.OutputDestination
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.OutputDestination{
+			Id: aws.String(tfMap["id"].(string)),
+		}
+
+		if v, ok := tfMap["media_package_settings"].([]interface{}); ok {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.MediaPackageSettings = append(apiObject.MediaPackageSettings, &medialive.MediaPackageOutputDestinationSettings{
+					ChannelId: aws.String(tfMap["channel_id"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["multiplex_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.MultiplexSettings = &medialive.MultiplexProgramChannelDestinationSettings{
+				MultiplexId: aws.String(tfMap["multiplex_id"].(string)),
+				ProgramName: aws.String(tfMap["program_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["settings"].([]interface{}); ok {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				settings := &medialive.OutputDestinationSettings{}
+
+				if v, ok := tfMap["password_param"].(string); ok && v != "" {
+					settings.PasswordParam = aws.String(v)
+				}
+
+				if v, ok := tfMap["stream_name"].(string); ok && v != "" {
+					settings.StreamName = aws.String(v)
+				}
+
+				if v, ok := tfMap["url"].(string); ok && v != "" {
+					settings.Url = aws.String(v)
+				}
+
+				if v, ok := tfMap["username"].(string); ok && v != "" {
+					settings.Username = aws.String(v)
+				}
+
+				apiObject.Settings = append(apiObject.Settings, settings)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenMediaLiveInputAttachments(apiObjects []*medialive.InputAttachment) ([]interface{}, error) {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"input_attachment_name": aws.StringValue(apiObject.InputAttachmentName),
+			"input_id":              aws.StringValue(apiObject.InputId),
+		}
+
+		if apiObject.InputSettings != nil {
+			inputSettings, err := jsonutil.BuildJSON(apiObject.InputSettings)
+
+			if err != nil {
+				return nil, err
+			}
+
+			tfMap["input_settings"] = string(inputSettings)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
+
+func flattenMediaLiveInputSpecification(apiObject *medialive.InputSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"codec":           aws.StringValue(apiObject.Codec),
+		"maximum_bitrate": aws.StringValue(apiObject.MaximumBitrate),
+		"resolution":      aws.StringValue(apiObject.Resolution),
+	}
+}
+
+func flattenMediaLiveOutputDestinations(apiObjects []*medialive.OutputDestination) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"id": aws.StringValue(apiObject.Id),
+		}
+
+		var mediaPackageSettings []interface{}
+
+		for _, v := range apiObject.MediaPackageSettings {
+			if v == nil {
+				continue
+			}
+
+			mediaPackageSettings = append(mediaPackageSettings, map[string]interface{}{
+				"channel_id": aws.StringValue(v.ChannelId),
+			})
+		}
+
+		tfMap["media_package_settings"] = mediaPackageSettings
+
+		if v := apiObject.MultiplexSettings; v != nil {
+			tfMap["multiplex_settings"] = []interface{}{map[string]interface{}{
+				"multiplex_id": aws.StringValue(v.MultiplexId),
+				"program_name": aws.StringValue(v.ProgramName),
+			}}
+		}
+
+		var settings []interface{}
+
+		for _, v := range apiObject.Settings {
+			if v == nil {
+				continue
+			}
+
+			settings = append(settings, map[string]interface{}{
+				"password_param": aws.StringValue(v.PasswordParam),
+				"stream_name":    aws.StringValue(v.StreamName),
+				"url":            aws.StringValue(v.Url),
+				"username":       aws.StringValue(v.Username),
+			})
+		}
+
+		tfMap["settings"] = settings
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,406 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_medialive_channel", &resource.Sweeper{
+		Name: "aws_medialive_channel",
+		F:    testSweepMediaLiveChannels,
+	})
+}
+
+func testSweepMediaLiveChannels(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).medialiveconn
+	var sweeperErrs *multierror.Error
+	input := &medialive.ListChannelsInput{}
+
+	err = conn.ListChannelsPages(input, func(page *medialive.ListChannelsOutput, isLast bool) bool {
+		if page == nil {
+			return !isLast
+		}
+
+		for _, channel := range page.Channels {
+			if channel == nil {
+				continue
+			}
+
+			id := aws.StringValue(channel.Id)
+			r := resourceAwsMediaLiveChannel()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			log.Printf("[INFO] Deleting MediaLive Channel: %s", id)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting MediaLive Channel (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !isLast
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaLive Channel sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing MediaLive Channels: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSMediaLiveChannel_basic(t *testing.T) {
+	var v medialive.DescribeChannelOutput
+	resourceName := "aws_medialive_channel.test"
+	inputResourceName := "aws_medialive_input.test"
+	roleResourceName := "aws_iam_role.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(medialive.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveChannelConfig(rName, rName, "ERROR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveChannelExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`channel:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "channel_class", medialive.ChannelClassSinglePipeline),
+					resource.TestCheckResourceAttr(resourceName, "destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.id", "destination1"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.settings.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "encoder_settings"),
+					resource.TestCheckResourceAttr(resourceName, "input_attachment.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_attachment.0.input_id", inputResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.0.codec", medialive.InputCodecAvc),
+					resource.TestCheckResourceAttr(resourceName, "log_level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaLiveChannelConfig(rName, rName+"-updated", "INFO"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "log_level", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveChannel_disappears(t *testing.T) {
+	var v medialive.DescribeChannelOutput
+	resourceName := "aws_medialive_channel.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(medialive.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveChannelConfig(rName, rName, "ERROR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveChannelExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMediaLiveChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveChannel_StartChannel(t *testing.T) {
+	var v medialive.DescribeChannelOutput
+	resourceName := "aws_medialive_channel.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(medialive.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveChannelConfigStartChannel(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveChannelExists(resourceName, &v),
+					testAccCheckAWSMediaLiveChannelState(&v, medialive.ChannelStateRunning),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "true"),
+				),
+			},
+			{
+				Config: testAccAWSMediaLiveChannelConfigStartChannel(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveChannelExists(resourceName, &v),
+					testAccCheckAWSMediaLiveChannelState(&v, medialive.ChannelStateIdle),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMediaLiveChannelExists(resourceName string, v *medialive.DescribeChannelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Channel ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+		output, err := finder.ChannelByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.StringValue(output.State) == medialive.ChannelStateDeleted {
+			return fmt.Errorf("MediaLive Channel (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSMediaLiveChannelState(v *medialive.DescribeChannelOutput, state string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.StringValue(v.State); got != state {
+			return fmt.Errorf("expected MediaLive Channel (%s) state %q, got %q", aws.StringValue(v.Id), state, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSMediaLiveChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_channel" {
+			continue
+		}
+
+		output, err := finder.ChannelByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.StringValue(output.State) == medialive.ChannelStateDeleted {
+			continue
+		}
+
+		return fmt.Errorf("MediaLive Channel (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSMediaLiveChannelConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "medialive.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:PutObject",
+        "s3:GetObject",
+        "s3:ListBucket"
+      ],
+      "Resource": [
+        "${aws_s3_bucket.test.arn}",
+        "${aws_s3_bucket.test.arn}/*"
+      ]
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "logs:CreateLogGroup",
+        "logs:CreateLogStream",
+        "logs:PutLogEvents",
+        "logs:DescribeLogStreams"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.0/16"
+  }
+}
+
+resource "aws_medialive_input" "test" {
+  name                  = %[1]q
+  type                  = "UDP_PUSH"
+  input_security_groups = [aws_medialive_input_security_group.test.id]
+}
+`, rName)
+}
+
+func testAccAWSMediaLiveChannelConfigResource(name, logLevel string, startChannel bool) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_channel" "test" {
+  name          = %[1]q
+  channel_class = "SINGLE_PIPELINE"
+  log_level     = %[2]q
+  role_arn      = aws_iam_role.test.arn
+  start_channel = %[3]t
+
+  destination {
+    id = "destination1"
+
+    settings {
+      url = "s3ssl://${aws_s3_bucket.test.id}/output"
+    }
+  }
+
+  encoder_settings = jsonencode({
+    audioDescriptions = [{
+      audioSelectorName = "default"
+      name              = "audio_1"
+    }]
+    outputGroups = [{
+      outputGroupSettings = {
+        archiveGroupSettings = {
+          destination = {
+            destinationRefId = "destination1"
+          }
+        }
+      }
+      outputs = [{
+        audioDescriptionNames = ["audio_1"]
+        outputName            = "output_1"
+        outputSettings = {
+          archiveOutputSettings = {
+            containerSettings = {
+              m2tsSettings = {}
+            }
+            extension    = "m2ts"
+            nameModifier = "-1"
+          }
+        }
+        videoDescriptionName = "video_1"
+      }]
+    }]
+    timecodeConfig = {
+      source = "EMBEDDED"
+    }
+    videoDescriptions = [{
+      height = 360
+      name   = "video_1"
+      width  = 640
+    }]
+  })
+
+  input_attachment {
+    input_attachment_name = "input_1"
+    input_id              = aws_medialive_input.test.id
+  }
+
+  input_specification {
+    codec           = "AVC"
+    maximum_bitrate = "MAX_10_MBPS"
+    resolution      = "SD"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, name, logLevel, startChannel)
+}
+
+func testAccAWSMediaLiveChannelConfig(rName, name, logLevel string) string {
+	return composeConfig(
+		testAccAWSMediaLiveChannelConfigBase(rName),
+		testAccAWSMediaLiveChannelConfigResource(name, logLevel, false),
+	)
+}
+
+func testAccAWSMediaLiveChannelConfigStartChannel(rName string, startChannel bool) string {
+	return composeConfig(
+		testAccAWSMediaLiveChannelConfigBase(rName),
+		testAccAWSMediaLiveChannelConfigResource(rName, "ERROR", startChannel),
+	)
+}
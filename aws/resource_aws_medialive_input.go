@@ -0,0 +1,580 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/waiter"
+)
+
+func resourceAwsMediaLiveInput() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveInputCreate,
+		Read:   resourceAwsMediaLiveInputRead,
+		Update: resourceAwsMediaLiveInputUpdate,
+		Delete: resourceAwsMediaLiveInputDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attached_channels": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stream_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"input_class": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"input_device": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"input_security_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"input_source_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"media_connect_flow": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flow_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"password_param": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(medialive.InputType_Values(), false),
+			},
+			"vpc": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 2,
+							MaxItems: 2,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMediaLiveInputCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &medialive.CreateInputInput{
+		Name: aws.String(name),
+		Type: aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("destination"); ok && len(v.([]interface{})) > 0 {
+		input.Destinations = expandMediaLiveInputDestinationRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("input_device"); ok && len(v.([]interface{})) > 0 {
+		input.InputDevices = expandMediaLiveInputDeviceSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("input_security_groups"); ok && len(v.([]interface{})) > 0 {
+		input.InputSecurityGroups = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("media_connect_flow"); ok && len(v.([]interface{})) > 0 {
+		input.MediaConnectFlows = expandMediaLiveMediaConnectFlowRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source"); ok && len(v.([]interface{})) > 0 {
+		input.Sources = expandMediaLiveInputSourceRequests(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().MedialiveTags()
+	}
+
+	if v, ok := d.GetOk("vpc"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Vpc = expandMediaLiveInputVpcRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Input: %s", input)
+	output, err := conn.CreateInput(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Input (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Input.Id))
+
+	if _, err := waiter.InputCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Input (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsMediaLiveInputRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.InputByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		log.Printf("[WARN] MediaLive Input (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Input (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || aws.StringValue(output.State) == medialive.InputStateDeleted {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading MediaLive Input (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] MediaLive Input (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("attached_channels", aws.StringValueSlice(output.AttachedChannels))
+
+	if err := d.Set("destination", flattenMediaLiveInputDestinations(output.Destinations)); err != nil {
+		return fmt.Errorf("error setting destination: %w", err)
+	}
+
+	d.Set("input_class", output.InputClass)
+
+	if err := d.Set("input_device", flattenMediaLiveInputDeviceSettings(output.InputDevices)); err != nil {
+		return fmt.Errorf("error setting input_device: %w", err)
+	}
+
+	d.Set("input_security_groups", aws.StringValueSlice(output.SecurityGroups))
+	d.Set("input_source_type", output.InputSourceType)
+
+	if err := d.Set("media_connect_flow", flattenMediaLiveMediaConnectFlows(output.MediaConnectFlows)); err != nil {
+		return fmt.Errorf("error setting media_connect_flow: %w", err)
+	}
+
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+
+	if err := d.Set("source", flattenMediaLiveInputSources(output.Sources)); err != nil {
+		return fmt.Errorf("error setting source: %w", err)
+	}
+
+	d.Set("state", output.State)
+	d.Set("type", output.Type)
+
+	tags := keyvaluetags.MedialiveKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveInputUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.HasChanges("destination", "input_device", "input_security_groups", "media_connect_flow", "name", "role_arn", "source") {
+		input := &medialive.UpdateInputInput{
+			InputId: aws.String(d.Id()),
+			Name:    aws.String(d.Get("name").(string)),
+		}
+
+		if d.HasChange("destination") {
+			input.Destinations = expandMediaLiveInputDestinationRequests(d.Get("destination").([]interface{}))
+		}
+
+		if d.HasChange("input_device") {
+			input.InputDevices = expandMediaLiveInputDeviceRequests(d.Get("input_device").([]interface{}))
+		}
+
+		if d.HasChange("input_security_groups") {
+			input.InputSecurityGroups = expandStringList(d.Get("input_security_groups").([]interface{}))
+		}
+
+		if d.HasChange("media_connect_flow") {
+			input.MediaConnectFlows = expandMediaLiveMediaConnectFlowRequests(d.Get("media_connect_flow").([]interface{}))
+		}
+
+		if d.HasChange("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		if d.HasChange("source") {
+			input.Sources = expandMediaLiveInputSourceRequests(d.Get("source").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Input: %s", input)
+		_, err := conn.UpdateInput(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaLive Input (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MedialiveUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaLive Input (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaLiveInputRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	log.Printf("[DEBUG] Deleting MediaLive Input: %s", d.Id())
+	_, err := conn.DeleteInput(&medialive.DeleteInputInput{
+		InputId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Input (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.InputDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Input (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMediaLiveInputDestinationRequests(tfList []interface{}) []*medialive.InputDestinationRequest {
+	var apiObjects []*medialive.InputDestinationRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.InputDestinationRequest{}
+
+		if v, ok := tfMap["stream_name"].(string); ok && v != "" {
+			apiObject.StreamName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMediaLiveInputDeviceSettings(tfList []interface{}) []*medialive.InputDeviceSettings {
+	var apiObjects []*medialive.InputDeviceSettings
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &medialive.InputDeviceSettings{
+			Id: aws.String(tfMap["id"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandMediaLiveInputDeviceRequests(tfList []interface{}) []*medialive.InputDeviceRequest {
+	var apiObjects []*medialive.InputDeviceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &medialive.InputDeviceRequest{
+			Id: aws.String(tfMap["id"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandMediaLiveMediaConnectFlowRequests(tfList []interface{}) []*medialive.MediaConnectFlowRequest {
+	var apiObjects []*medialive.MediaConnectFlowRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &medialive.MediaConnectFlowRequest{
+			FlowArn: aws.String(tfMap["flow_arn"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandMediaLiveInputSourceRequests(tfList []interface{}) []*medialive.InputSourceRequest {
+	var apiObjects []*medialive.InputSourceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.InputSourceRequest{
+			Url: aws.String(tfMap["url"].(string)),
+		}
+
+		if v, ok := tfMap["password_param"].(string); ok && v != "" {
+			apiObject.PasswordParam = aws.String(v)
+		}
+
+		if v, ok := tfMap["username"].(string); ok && v != "" {
+			apiObject.Username = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMediaLiveInputVpcRequest(tfMap map[string]interface{}) *medialive.InputVpcRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.InputVpcRequest{}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenMediaLiveInputDestinations(apiObjects []*medialive.InputDestination) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"ip":   aws.StringValue(apiObject.Ip),
+			"port": aws.StringValue(apiObject.Port),
+			"url":  aws.StringValue(apiObject.Url),
+		}
+
+		// The API does not return the requested stream name, only the full push URL.
+		if u, err := url.Parse(aws.StringValue(apiObject.Url)); err == nil {
+			tfMap["stream_name"] = strings.TrimPrefix(u.Path, "/")
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMediaLiveInputDeviceSettings(apiObjects []*medialive.InputDeviceSettings) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"id": aws.StringValue(apiObject.Id),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMediaLiveMediaConnectFlows(apiObjects []*medialive.MediaConnectFlow) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"flow_arn": aws.StringValue(apiObject.FlowArn),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMediaLiveInputSources(apiObjects []*medialive.InputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"password_param": aws.StringValue(apiObject.PasswordParam),
+			"url":            aws.StringValue(apiObject.Url),
+			"username":       aws.StringValue(apiObject.Username),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,213 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/waiter"
+)
+
+func resourceAwsMediaLiveInputSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveInputSecurityGroupCreate,
+		Read:   resourceAwsMediaLiveInputSecurityGroupRead,
+		Update: resourceAwsMediaLiveInputSecurityGroupUpdate,
+		Delete: resourceAwsMediaLiveInputSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inputs": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"whitelist_rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIpv4CIDRNetworkAddress,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMediaLiveInputSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &medialive.CreateInputSecurityGroupInput{
+		WhitelistRules: expandMediaLiveInputWhitelistRuleCidrs(d.Get("whitelist_rule").(*schema.Set).List()),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().MedialiveTags()
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Input Security Group: %s", input)
+	output, err := conn.CreateInputSecurityGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Input Security Group: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.SecurityGroup.Id))
+
+	return resourceAwsMediaLiveInputSecurityGroupRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.InputSecurityGroupByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		log.Printf("[WARN] MediaLive Input Security Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Input Security Group (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || aws.StringValue(output.State) == medialive.InputSecurityGroupStateDeleted {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading MediaLive Input Security Group (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] MediaLive Input Security Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("inputs", aws.StringValueSlice(output.Inputs))
+
+	if err := d.Set("whitelist_rule", flattenMediaLiveInputWhitelistRules(output.WhitelistRules)); err != nil {
+		return fmt.Errorf("error setting whitelist_rule: %w", err)
+	}
+
+	tags := keyvaluetags.MedialiveKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveInputSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.HasChange("whitelist_rule") {
+		input := &medialive.UpdateInputSecurityGroupInput{
+			InputSecurityGroupId: aws.String(d.Id()),
+			WhitelistRules:       expandMediaLiveInputWhitelistRuleCidrs(d.Get("whitelist_rule").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Input Security Group: %s", input)
+		_, err := conn.UpdateInputSecurityGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaLive Input Security Group (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.InputSecurityGroupUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MediaLive Input Security Group (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MedialiveUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaLive Input Security Group (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaLiveInputSecurityGroupRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	log.Printf("[DEBUG] Deleting MediaLive Input Security Group: %s", d.Id())
+	_, err := conn.DeleteInputSecurityGroup(&medialive.DeleteInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Input Security Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMediaLiveInputWhitelistRuleCidrs(tfList []interface{}) []*medialive.InputWhitelistRuleCidr {
+	var apiObjects []*medialive.InputWhitelistRuleCidr
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &medialive.InputWhitelistRuleCidr{
+			Cidr: aws.String(tfMap["cidr"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenMediaLiveInputWhitelistRules(apiObjects []*medialive.InputWhitelistRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"cidr": aws.StringValue(apiObject.Cidr),
+		})
+	}
+
+	return tfList
+}
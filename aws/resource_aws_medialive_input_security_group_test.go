@@ -0,0 +1,278 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_medialive_input_security_group", &resource.Sweeper{
+		Name: "aws_medialive_input_security_group",
+		F:    testSweepMediaLiveInputSecurityGroups,
+		Dependencies: []string{
+			"aws_medialive_input",
+		},
+	})
+}
+
+func testSweepMediaLiveInputSecurityGroups(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).medialiveconn
+	var sweeperErrs *multierror.Error
+	input := &medialive.ListInputSecurityGroupsInput{}
+
+	err = conn.ListInputSecurityGroupsPages(input, func(page *medialive.ListInputSecurityGroupsOutput, isLast bool) bool {
+		if page == nil {
+			return !isLast
+		}
+
+		for _, inputSecurityGroup := range page.InputSecurityGroups {
+			if inputSecurityGroup == nil {
+				continue
+			}
+
+			id := aws.StringValue(inputSecurityGroup.Id)
+			r := resourceAwsMediaLiveInputSecurityGroup()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			log.Printf("[INFO] Deleting MediaLive Input Security Group: %s", id)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting MediaLive Input Security Group (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !isLast
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaLive Input Security Group sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing MediaLive Input Security Groups: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSMediaLiveInputSecurityGroup_basic(t *testing.T) {
+	var v medialive.DescribeInputSecurityGroupOutput
+	resourceName := "aws_medialive_input_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(medialive.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveInputSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveInputSecurityGroupConfig("10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputSecurityGroupExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`inputSecurityGroup:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "inputs.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "whitelist_rule.*", map[string]string{
+						"cidr": "10.0.0.0/16",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaLiveInputSecurityGroupConfig("10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputSecurityGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "whitelist_rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "whitelist_rule.*", map[string]string{
+						"cidr": "10.1.0.0/16",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveInputSecurityGroup_disappears(t *testing.T) {
+	var v medialive.DescribeInputSecurityGroupOutput
+	resourceName := "aws_medialive_input_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(medialive.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveInputSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveInputSecurityGroupConfig("10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputSecurityGroupExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMediaLiveInputSecurityGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveInputSecurityGroup_tags(t *testing.T) {
+	var v medialive.DescribeInputSecurityGroupOutput
+	resourceName := "aws_medialive_input_security_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(medialive.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveInputSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveInputSecurityGroupConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputSecurityGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaLiveInputSecurityGroupConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputSecurityGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMediaLiveInputSecurityGroupConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputSecurityGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMediaLiveInputSecurityGroupExists(resourceName string, v *medialive.DescribeInputSecurityGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Input Security Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+		output, err := finder.InputSecurityGroupByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.StringValue(output.State) == medialive.InputSecurityGroupStateDeleted {
+			return fmt.Errorf("MediaLive Input Security Group (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSMediaLiveInputSecurityGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_input_security_group" {
+			continue
+		}
+
+		output, err := finder.InputSecurityGroupByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.StringValue(output.State) == medialive.InputSecurityGroupStateDeleted {
+			continue
+		}
+
+		return fmt.Errorf("MediaLive Input Security Group (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSMediaLiveInputSecurityGroupConfig(cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = %[1]q
+  }
+}
+`, cidr)
+}
+
+func testAccAWSMediaLiveInputSecurityGroupConfigTags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.0/16"
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAWSMediaLiveInputSecurityGroupConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.0/16"
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,301 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_medialive_input", &resource.Sweeper{
+		Name: "aws_medialive_input",
+		F:    testSweepMediaLiveInputs,
+		Dependencies: []string{
+			"aws_medialive_channel",
+		},
+	})
+}
+
+func testSweepMediaLiveInputs(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).medialiveconn
+	var sweeperErrs *multierror.Error
+	input := &medialive.ListInputsInput{}
+
+	err = conn.ListInputsPages(input, func(page *medialive.ListInputsOutput, isLast bool) bool {
+		if page == nil {
+			return !isLast
+		}
+
+		for _, mediaLiveInput := range page.Inputs {
+			if mediaLiveInput == nil {
+				continue
+			}
+
+			id := aws.StringValue(mediaLiveInput.Id)
+			r := resourceAwsMediaLiveInput()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			log.Printf("[INFO] Deleting MediaLive Input: %s", id)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting MediaLive Input (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !isLast
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaLive Input sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing MediaLive Inputs: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSMediaLiveInput_basic(t *testing.T) {
+	var v medialive.DescribeInputOutput
+	resourceName := "aws_medialive_input.test"
+	securityGroupResourceName := "aws_medialive_input_security_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(medialive.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveInputConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`input:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "attached_channels.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "destination.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "input_class", medialive.InputClassStandard),
+					resource.TestCheckResourceAttr(resourceName, "input_security_groups.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_security_groups.0", securityGroupResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", medialive.InputStateDetached),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", medialive.InputTypeRtmpPush),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaLiveInputConfig(rName, rName+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveInput_disappears(t *testing.T) {
+	var v medialive.DescribeInputOutput
+	resourceName := "aws_medialive_input.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(medialive.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveInputConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMediaLiveInput(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveInput_tags(t *testing.T) {
+	var v medialive.DescribeInputOutput
+	resourceName := "aws_medialive_input.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(medialive.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveInputConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaLiveInputConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMediaLiveInputConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveInputExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMediaLiveInputExists(resourceName string, v *medialive.DescribeInputOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Input ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+		output, err := finder.InputByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.StringValue(output.State) == medialive.InputStateDeleted {
+			return fmt.Errorf("MediaLive Input (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSMediaLiveInputDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_input" {
+			continue
+		}
+
+		output, err := finder.InputByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.StringValue(output.State) == medialive.InputStateDeleted {
+			continue
+		}
+
+		return fmt.Errorf("MediaLive Input (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSMediaLiveInputConfigBase() string {
+	return `
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.0/16"
+  }
+}
+`
+}
+
+func testAccAWSMediaLiveInputConfig(rName, name string) string {
+	return composeConfig(testAccAWSMediaLiveInputConfigBase(), fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name                  = %[2]q
+  type                  = "RTMP_PUSH"
+  input_security_groups = [aws_medialive_input_security_group.test.id]
+
+  destination {
+    stream_name = "%[1]s/primary"
+  }
+
+  destination {
+    stream_name = "%[1]s/secondary"
+  }
+}
+`, rName, name))
+}
+
+func testAccAWSMediaLiveInputConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSMediaLiveInputConfigBase(), fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name                  = %[1]q
+  type                  = "UDP_PUSH"
+  input_security_groups = [aws_medialive_input_security_group.test.id]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSMediaLiveInputConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSMediaLiveInputConfigBase(), fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name                  = %[1]q
+  type                  = "UDP_PUSH"
+  input_security_groups = [aws_medialive_input_security_group.test.id]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,357 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/waiter"
+)
+
+func resourceAwsMediaLiveMultiplex() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveMultiplexCreate,
+		Read:   resourceAwsMediaLiveMultiplexRead,
+		Update: resourceAwsMediaLiveMultiplexUpdate,
+		Delete: resourceAwsMediaLiveMultiplexDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 2,
+				MaxItems: 2,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"multiplex_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_video_buffer_delay_milliseconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(800, 3000),
+						},
+						"transport_stream_bitrate": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1000000, 100000000),
+						},
+						"transport_stream_id": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"transport_stream_reserved_bitrate": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 100000000),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"start_multiplex": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsMediaLiveMultiplexCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &medialive.CreateMultiplexInput{
+		AvailabilityZones: expandStringList(d.Get("availability_zones").([]interface{})),
+		Name:              aws.String(name),
+	}
+
+	if v, ok := d.GetOk("multiplex_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MultiplexSettings = expandMediaLiveMultiplexSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().MedialiveTags()
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Multiplex: %s", input)
+	output, err := conn.CreateMultiplex(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Multiplex (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Multiplex.Id))
+
+	if _, err := waiter.MultiplexCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Multiplex (%s) creation: %w", d.Id(), err)
+	}
+
+	if d.Get("start_multiplex").(bool) {
+		if err := resourceAwsMediaLiveMultiplexStart(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMediaLiveMultiplexRead(d, meta)
+}
+
+func resourceAwsMediaLiveMultiplexRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := finder.MultiplexByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		log.Printf("[WARN] MediaLive Multiplex (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Multiplex (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || aws.StringValue(output.State) == medialive.MultiplexStateDeleted {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading MediaLive Multiplex (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] MediaLive Multiplex (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("availability_zones", aws.StringValueSlice(output.AvailabilityZones))
+
+	if output.MultiplexSettings != nil {
+		if err := d.Set("multiplex_settings", []interface{}{flattenMediaLiveMultiplexSettings(output.MultiplexSettings)}); err != nil {
+			return fmt.Errorf("error setting multiplex_settings: %w", err)
+		}
+	} else {
+		d.Set("multiplex_settings", nil)
+	}
+
+	d.Set("name", output.Name)
+
+	switch aws.StringValue(output.State) {
+	case medialive.MultiplexStateStarting, medialive.MultiplexStateRunning, medialive.MultiplexStateRecovering:
+		d.Set("start_multiplex", true)
+	default:
+		d.Set("start_multiplex", false)
+	}
+
+	tags := keyvaluetags.MedialiveKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveMultiplexUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.HasChanges("multiplex_settings", "name") {
+		output, err := finder.MultiplexByID(conn, d.Id())
+
+		if err != nil {
+			return fmt.Errorf("error reading MediaLive Multiplex (%s): %w", d.Id(), err)
+		}
+
+		// A multiplex can only be updated while it is idle.
+		if output != nil && aws.StringValue(output.State) == medialive.MultiplexStateRunning {
+			if err := resourceAwsMediaLiveMultiplexStop(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+
+		input := &medialive.UpdateMultiplexInput{
+			MultiplexId: aws.String(d.Id()),
+			Name:        aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("multiplex_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.MultiplexSettings = expandMediaLiveMultiplexSettings(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Multiplex: %s", input)
+		_, err = conn.UpdateMultiplex(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaLive Multiplex (%s): %w", d.Id(), err)
+		}
+
+		if d.Get("start_multiplex").(bool) {
+			if err := resourceAwsMediaLiveMultiplexStart(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+	} else if d.HasChange("start_multiplex") {
+		if d.Get("start_multiplex").(bool) {
+			if err := resourceAwsMediaLiveMultiplexStart(conn, d.Id()); err != nil {
+				return err
+			}
+		} else {
+			if err := resourceAwsMediaLiveMultiplexStop(conn, d.Id()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MedialiveUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaLive Multiplex (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaLiveMultiplexRead(d, meta)
+}
+
+func resourceAwsMediaLiveMultiplexDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	output, err := finder.MultiplexByID(conn, d.Id())
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Multiplex (%s): %w", d.Id(), err)
+	}
+
+	// A running multiplex must be stopped before it can be deleted.
+	if output != nil && aws.StringValue(output.State) == medialive.MultiplexStateRunning {
+		if err := resourceAwsMediaLiveMultiplexStop(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting MediaLive Multiplex: %s", d.Id())
+	_, err = conn.DeleteMultiplex(&medialive.DeleteMultiplexInput{
+		MultiplexId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Multiplex (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.MultiplexDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Multiplex (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveMultiplexStart(conn *medialive.MediaLive, id string) error {
+	log.Printf("[DEBUG] Starting MediaLive Multiplex: %s", id)
+	_, err := conn.StartMultiplex(&medialive.StartMultiplexInput{
+		MultiplexId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error starting MediaLive Multiplex (%s): %w", id, err)
+	}
+
+	if _, err := waiter.MultiplexStarted(conn, id); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Multiplex (%s) start: %w", id, err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveMultiplexStop(conn *medialive.MediaLive, id string) error {
+	log.Printf("[DEBUG] Stopping MediaLive Multiplex: %s", id)
+	_, err := conn.StopMultiplex(&medialive.StopMultiplexInput{
+		MultiplexId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error stopping MediaLive Multiplex (%s): %w", id, err)
+	}
+
+	if _, err := waiter.MultiplexStopped(conn, id); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Multiplex (%s) stop: %w", id, err)
+	}
+
+	return nil
+}
+
+func expandMediaLiveMultiplexSettings(tfMap map[string]interface{}) *medialive.MultiplexSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &medialive.MultiplexSettings{
+		TransportStreamBitrate: aws.Int64(int64(tfMap["transport_stream_bitrate"].(int))),
+		TransportStreamId:      aws.Int64(int64(tfMap["transport_stream_id"].(int))),
+	}
+
+	if v, ok := tfMap["maximum_video_buffer_delay_milliseconds"].(int); ok && v != 0 {
+		apiObject.MaximumVideoBufferDelayMilliseconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["transport_stream_reserved_bitrate"].(int); ok && v != 0 {
+		apiObject.TransportStreamReservedBitrate = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenMediaLiveMultiplexSettings(apiObject *medialive.MultiplexSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"maximum_video_buffer_delay_milliseconds": aws.Int64Value(apiObject.MaximumVideoBufferDelayMilliseconds),
+		"transport_stream_bitrate":                aws.Int64Value(apiObject.TransportStreamBitrate),
+		"transport_stream_id":                     aws.Int64Value(apiObject.TransportStreamId),
+		"transport_stream_reserved_bitrate":       aws.Int64Value(apiObject.TransportStreamReservedBitrate),
+	}
+}
@@ -0,0 +1,292 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/medialive/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_medialive_multiplex", &resource.Sweeper{
+		Name: "aws_medialive_multiplex",
+		F:    testSweepMediaLiveMultiplexes,
+		Dependencies: []string{
+			"aws_medialive_channel",
+		},
+	})
+}
+
+func testSweepMediaLiveMultiplexes(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).medialiveconn
+	var sweeperErrs *multierror.Error
+	input := &medialive.ListMultiplexesInput{}
+
+	err = conn.ListMultiplexesPages(input, func(page *medialive.ListMultiplexesOutput, isLast bool) bool {
+		if page == nil {
+			return !isLast
+		}
+
+		for _, multiplex := range page.Multiplexes {
+			if multiplex == nil {
+				continue
+			}
+
+			id := aws.StringValue(multiplex.Id)
+			r := resourceAwsMediaLiveMultiplex()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			log.Printf("[INFO] Deleting MediaLive Multiplex: %s", id)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting MediaLive Multiplex (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !isLast
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaLive Multiplex sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing MediaLive Multiplexes: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSMediaLiveMultiplex_basic(t *testing.T) {
+	var v medialive.DescribeMultiplexOutput
+	resourceName := "aws_medialive_multiplex.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(medialive.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveMultiplexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveMultiplexConfig(rName, 1000000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveMultiplexExists(resourceName, &v),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`multiplex:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "availability_zones.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "multiplex_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "multiplex_settings.0.transport_stream_bitrate", "1000000"),
+					resource.TestCheckResourceAttr(resourceName, "multiplex_settings.0.transport_stream_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "start_multiplex", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaLiveMultiplexConfig(rName, 2000000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveMultiplexExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "multiplex_settings.0.transport_stream_bitrate", "2000000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveMultiplex_disappears(t *testing.T) {
+	var v medialive.DescribeMultiplexOutput
+	resourceName := "aws_medialive_multiplex.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(medialive.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveMultiplexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveMultiplexConfig(rName, 1000000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveMultiplexExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsMediaLiveMultiplex(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveMultiplex_tags(t *testing.T) {
+	var v medialive.DescribeMultiplexOutput
+	resourceName := "aws_medialive_multiplex.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(medialive.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSMediaLiveMultiplexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSMediaLiveMultiplexConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveMultiplexExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSMediaLiveMultiplexConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveMultiplexExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSMediaLiveMultiplexConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSMediaLiveMultiplexExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSMediaLiveMultiplexExists(resourceName string, v *medialive.DescribeMultiplexOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Multiplex ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+		output, err := finder.MultiplexByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.StringValue(output.State) == medialive.MultiplexStateDeleted {
+			return fmt.Errorf("MediaLive Multiplex (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSMediaLiveMultiplexDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_multiplex" {
+			continue
+		}
+
+		output, err := finder.MultiplexByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || aws.StringValue(output.State) == medialive.MultiplexStateDeleted {
+			continue
+		}
+
+		return fmt.Errorf("MediaLive Multiplex (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSMediaLiveMultiplexConfig(rName string, bitrate int) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_medialive_multiplex" "test" {
+  name               = %[1]q
+  availability_zones = slice(data.aws_availability_zones.available.names, 0, 2)
+
+  multiplex_settings {
+    transport_stream_bitrate = %[2]d
+    transport_stream_id      = 1
+  }
+}
+`, rName, bitrate))
+}
+
+func testAccAWSMediaLiveMultiplexConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_medialive_multiplex" "test" {
+  name               = %[1]q
+  availability_zones = slice(data.aws_availability_zones.available.names, 0, 2)
+
+  multiplex_settings {
+    transport_stream_bitrate = 1000000
+    transport_stream_id      = 1
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSMediaLiveMultiplexConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAvailableAZsNoOptInConfig(), fmt.Sprintf(`
+resource "aws_medialive_multiplex" "test" {
+  name               = %[1]q
+  availability_zones = slice(data.aws_availability_zones.available.names, 0, 2)
+
+  multiplex_settings {
+    transport_stream_bitrate = 1000000
+    transport_stream_id      = 1
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
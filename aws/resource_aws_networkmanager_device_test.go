@@ -0,0 +1,331 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_networkmanager_device", &resource.Sweeper{
+		Name: "aws_networkmanager_device",
+		F:    testSweepNetworkManagerDevices,
+		Dependencies: []string{
+			"aws_networkmanager_link_association",
+		},
+	})
+}
+
+func testSweepNetworkManagerDevices(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).networkmanagerconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.DescribeGlobalNetworksPages(&networkmanager.DescribeGlobalNetworksInput{}, func(page *networkmanager.DescribeGlobalNetworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, globalNetwork := range page.GlobalNetworks {
+			if globalNetwork == nil {
+				continue
+			}
+
+			globalNetworkID := aws.StringValue(globalNetwork.GlobalNetworkId)
+			input := &networkmanager.GetDevicesInput{
+				GlobalNetworkId: aws.String(globalNetworkID),
+			}
+
+			err := conn.GetDevicesPages(input, func(page *networkmanager.GetDevicesOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, device := range page.Devices {
+					if device == nil {
+						continue
+					}
+
+					id := aws.StringValue(device.DeviceId)
+					r := resourceAwsNetworkManagerDevice()
+					d := r.Data(nil)
+					d.SetId(id)
+					d.Set("global_network_id", globalNetworkID)
+
+					log.Printf("[INFO] Deleting Network Manager Device: %s", id)
+					if err := r.Delete(d, client); err != nil {
+						sweeperErr := fmt.Errorf("error deleting Network Manager Device (%s): %w", id, err)
+						log.Printf("[ERROR] %s", sweeperErr)
+						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+						continue
+					}
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Network Manager Devices for Global Network (%s): %w", globalNetworkID, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Device sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Network Manager Global Networks: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSNetworkManagerDevice_basic(t *testing.T) {
+	resourceName := "aws_networkmanager_device.test"
+	globalNetworkResourceName := "aws_networkmanager_global_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerDeviceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerDeviceExists(resourceName),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "networkmanager", regexp.MustCompile(`device/global-network-.+/device-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", globalNetworkResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "model", ""),
+					resource.TestCheckResourceAttr(resourceName, "serial_number", ""),
+					resource.TestCheckResourceAttr(resourceName, "site_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", ""),
+					resource.TestCheckResourceAttr(resourceName, "vendor", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerDevice_disappears(t *testing.T) {
+	resourceName := "aws_networkmanager_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerDeviceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerDeviceExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsNetworkManagerDevice(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerDevice_AllAttributes(t *testing.T) {
+	resourceName := "aws_networkmanager_device.test"
+	siteResourceName := "aws_networkmanager_site.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerDeviceConfigAllAttributes("description1", "model1", "sn1", "type1", "vendor1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.address", "Address 1"),
+					resource.TestCheckResourceAttr(resourceName, "model", "model1"),
+					resource.TestCheckResourceAttr(resourceName, "serial_number", "sn1"),
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", siteResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type1"),
+					resource.TestCheckResourceAttr(resourceName, "vendor", "vendor1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkManagerDeviceConfigAllAttributes("description2", "model2", "sn2", "type2", "vendor2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "model", "model2"),
+					resource.TestCheckResourceAttr(resourceName, "serial_number", "sn2"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type2"),
+					resource.TestCheckResourceAttr(resourceName, "vendor", "vendor2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerDevice_Tags(t *testing.T) {
+	resourceName := "aws_networkmanager_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerDeviceConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkManagerDeviceConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerDeviceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkManagerDeviceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_device" {
+			continue
+		}
+
+		device, err := finder.DeviceByID(conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Network Manager Device (%s): %w", rs.Primary.ID, err)
+		}
+
+		if device == nil {
+			continue
+		}
+
+		return fmt.Errorf("Network Manager Device (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSNetworkManagerDeviceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+		device, err := finder.DeviceByID(conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Network Manager Device (%s): %w", rs.Primary.ID, err)
+		}
+
+		if device == nil {
+			return fmt.Errorf("Network Manager Device (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSNetworkManagerDeviceConfigBasic() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+`
+}
+
+func testAccAWSNetworkManagerDeviceConfigAllAttributes(description, model, serialNumber, deviceType, vendor string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  description       = %[1]q
+  model             = %[2]q
+  serial_number     = %[3]q
+  site_id           = aws_networkmanager_site.test.id
+  type              = %[4]q
+  vendor            = %[5]q
+
+  location {
+    address = "Address 1"
+  }
+}
+`, description, model, serialNumber, deviceType, vendor)
+}
+
+func testAccAWSNetworkManagerDeviceConfigTags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
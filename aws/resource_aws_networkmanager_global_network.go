@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/waiter"
+)
+
+func resourceAwsNetworkManagerGlobalNetwork() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkManagerGlobalNetworkCreate,
+		Read:   resourceAwsNetworkManagerGlobalNetworkRead,
+		Update: resourceAwsNetworkManagerGlobalNetworkUpdate,
+		Delete: resourceAwsNetworkManagerGlobalNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.AvailableTimeout),
+			Update: schema.DefaultTimeout(waiter.AvailableTimeout),
+			Delete: schema.DefaultTimeout(waiter.DeletedTimeout),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsNetworkManagerGlobalNetworkCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &networkmanager.CreateGlobalNetworkInput{}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().NetworkmanagerTags()
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Global Network: %s", input)
+	output, err := conn.CreateGlobalNetwork(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Network Manager Global Network: %w", err)
+	}
+
+	if output == nil || output.GlobalNetwork == nil {
+		return fmt.Errorf("error creating Network Manager Global Network: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.GlobalNetwork.GlobalNetworkId))
+
+	if _, err := waiter.GlobalNetworkAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Global Network (%s) to become available: %w", d.Id(), err)
+	}
+
+	return resourceAwsNetworkManagerGlobalNetworkRead(d, meta)
+}
+
+func resourceAwsNetworkManagerGlobalNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	globalNetwork, err := finder.GlobalNetworkByID(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Global Network (%s): %w", d.Id(), err)
+	}
+
+	if globalNetwork == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Network Manager Global Network (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Network Manager Global Network (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", globalNetwork.GlobalNetworkArn)
+	d.Set("description", globalNetwork.Description)
+
+	tags := keyvaluetags.NetworkmanagerKeyValueTags(globalNetwork.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsNetworkManagerGlobalNetworkUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	if d.HasChange("description") {
+		input := &networkmanager.UpdateGlobalNetworkInput{
+			Description:     aws.String(d.Get("description").(string)),
+			GlobalNetworkId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Network Manager Global Network: %s", input)
+		_, err := conn.UpdateGlobalNetwork(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Network Manager Global Network (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.GlobalNetworkAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Network Manager Global Network (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.NetworkmanagerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Network Manager Global Network (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsNetworkManagerGlobalNetworkRead(d, meta)
+}
+
+func resourceAwsNetworkManagerGlobalNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	log.Printf("[DEBUG] Deleting Network Manager Global Network: %s", d.Id())
+	_, err := conn.DeleteGlobalNetwork(&networkmanager.DeleteGlobalNetworkInput{
+		GlobalNetworkId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Network Manager Global Network (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.GlobalNetworkDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Global Network (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
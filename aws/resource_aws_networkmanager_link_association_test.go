@@ -0,0 +1,220 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfnetworkmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_networkmanager_link_association", &resource.Sweeper{
+		Name: "aws_networkmanager_link_association",
+		F:    testSweepNetworkManagerLinkAssociations,
+	})
+}
+
+func testSweepNetworkManagerLinkAssociations(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).networkmanagerconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.DescribeGlobalNetworksPages(&networkmanager.DescribeGlobalNetworksInput{}, func(page *networkmanager.DescribeGlobalNetworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, globalNetwork := range page.GlobalNetworks {
+			if globalNetwork == nil {
+				continue
+			}
+
+			globalNetworkID := aws.StringValue(globalNetwork.GlobalNetworkId)
+			input := &networkmanager.GetLinkAssociationsInput{
+				GlobalNetworkId: aws.String(globalNetworkID),
+			}
+
+			err := conn.GetLinkAssociationsPages(input, func(page *networkmanager.GetLinkAssociationsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, linkAssociation := range page.LinkAssociations {
+					if linkAssociation == nil {
+						continue
+					}
+
+					id := tfnetworkmanager.LinkAssociationCreateResourceID(globalNetworkID, aws.StringValue(linkAssociation.LinkId), aws.StringValue(linkAssociation.DeviceId))
+					r := resourceAwsNetworkManagerLinkAssociation()
+					d := r.Data(nil)
+					d.SetId(id)
+
+					log.Printf("[INFO] Deleting Network Manager Link Association: %s", id)
+					if err := r.Delete(d, client); err != nil {
+						sweeperErr := fmt.Errorf("error deleting Network Manager Link Association (%s): %w", id, err)
+						log.Printf("[ERROR] %s", sweeperErr)
+						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+						continue
+					}
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Network Manager Link Associations for Global Network (%s): %w", globalNetworkID, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Link Association sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Network Manager Global Networks: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSNetworkManagerLinkAssociation_basic(t *testing.T) {
+	resourceName := "aws_networkmanager_link_association.test"
+	deviceResourceName := "aws_networkmanager_device.test"
+	globalNetworkResourceName := "aws_networkmanager_global_network.test"
+	linkResourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerLinkAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerLinkAssociationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "device_id", deviceResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", globalNetworkResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "link_id", linkResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerLinkAssociation_disappears(t *testing.T) {
+	resourceName := "aws_networkmanager_link_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerLinkAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerLinkAssociationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkAssociationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsNetworkManagerLinkAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkManagerLinkAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_link_association" {
+			continue
+		}
+
+		globalNetworkID, linkID, deviceID, err := tfnetworkmanager.LinkAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		linkAssociation, err := finder.LinkAssociationByID(conn, globalNetworkID, linkID, deviceID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Network Manager Link Association (%s): %w", rs.Primary.ID, err)
+		}
+
+		if linkAssociation == nil || aws.StringValue(linkAssociation.LinkAssociationState) == networkmanager.LinkAssociationStateDeleted {
+			continue
+		}
+
+		return fmt.Errorf("Network Manager Link Association (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSNetworkManagerLinkAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		globalNetworkID, linkID, deviceID, err := tfnetworkmanager.LinkAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+		linkAssociation, err := finder.LinkAssociationByID(conn, globalNetworkID, linkID, deviceID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Network Manager Link Association (%s): %w", rs.Primary.ID, err)
+		}
+
+		if linkAssociation == nil {
+			return fmt.Errorf("Network Manager Link Association (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSNetworkManagerLinkAssociationConfig() string {
+	return composeConfig(
+		testAccAWSNetworkManagerLinkConfigBasic(),
+		`
+resource "aws_networkmanager_device" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+}
+
+resource "aws_networkmanager_link_association" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  link_id           = aws_networkmanager_link.test.id
+  device_id         = aws_networkmanager_device.test.id
+}
+`)
+}
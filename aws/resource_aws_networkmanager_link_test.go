@@ -0,0 +1,345 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_networkmanager_link", &resource.Sweeper{
+		Name: "aws_networkmanager_link",
+		F:    testSweepNetworkManagerLinks,
+		Dependencies: []string{
+			"aws_networkmanager_link_association",
+		},
+	})
+}
+
+func testSweepNetworkManagerLinks(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).networkmanagerconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.DescribeGlobalNetworksPages(&networkmanager.DescribeGlobalNetworksInput{}, func(page *networkmanager.DescribeGlobalNetworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, globalNetwork := range page.GlobalNetworks {
+			if globalNetwork == nil {
+				continue
+			}
+
+			globalNetworkID := aws.StringValue(globalNetwork.GlobalNetworkId)
+			input := &networkmanager.GetLinksInput{
+				GlobalNetworkId: aws.String(globalNetworkID),
+			}
+
+			err := conn.GetLinksPages(input, func(page *networkmanager.GetLinksOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, link := range page.Links {
+					if link == nil {
+						continue
+					}
+
+					id := aws.StringValue(link.LinkId)
+					r := resourceAwsNetworkManagerLink()
+					d := r.Data(nil)
+					d.SetId(id)
+					d.Set("global_network_id", globalNetworkID)
+
+					log.Printf("[INFO] Deleting Network Manager Link: %s", id)
+					if err := r.Delete(d, client); err != nil {
+						sweeperErr := fmt.Errorf("error deleting Network Manager Link (%s): %w", id, err)
+						log.Printf("[ERROR] %s", sweeperErr)
+						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+						continue
+					}
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Network Manager Links for Global Network (%s): %w", globalNetworkID, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager Link sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Network Manager Global Networks: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSNetworkManagerLink_basic(t *testing.T) {
+	resourceName := "aws_networkmanager_link.test"
+	globalNetworkResourceName := "aws_networkmanager_global_network.test"
+	siteResourceName := "aws_networkmanager_site.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerLinkConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkExists(resourceName),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "networkmanager", regexp.MustCompile(`link/global-network-.+/link-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.download_speed", "50"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.upload_speed", "10"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "global_network_id", globalNetworkResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", siteResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerLink_disappears(t *testing.T) {
+	resourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerLinkConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsNetworkManagerLink(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerLink_AllAttributes(t *testing.T) {
+	resourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerLinkConfigAllAttributes("description1", "provider1", "type1", 100, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.download_speed", "100"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.upload_speed", "20"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "provider1"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkManagerLinkConfigAllAttributes("description2", "provider2", "type2", 200, 40),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.download_speed", "200"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth.0.upload_speed", "40"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "provider2"),
+					resource.TestCheckResourceAttr(resourceName, "type", "type2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSNetworkManagerLink_Tags(t *testing.T) {
+	resourceName := "aws_networkmanager_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(networkmanager.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSNetworkManagerLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSNetworkManagerLinkConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSNetworkManagerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSNetworkManagerLinkConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSNetworkManagerLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSNetworkManagerLinkDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_networkmanager_link" {
+			continue
+		}
+
+		link, err := finder.LinkByID(conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Network Manager Link (%s): %w", rs.Primary.ID, err)
+		}
+
+		if link == nil {
+			continue
+		}
+
+		return fmt.Errorf("Network Manager Link (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSNetworkManagerLinkExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).networkmanagerconn
+
+		link, err := finder.LinkByID(conn, rs.Primary.Attributes["global_network_id"], rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Network Manager Link (%s): %w", rs.Primary.ID, err)
+		}
+
+		if link == nil {
+			return fmt.Errorf("Network Manager Link (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSNetworkManagerLinkConfigBase() string {
+	return `
+resource "aws_networkmanager_global_network" "test" {}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+`
+}
+
+func testAccAWSNetworkManagerLinkConfigBasic() string {
+	return composeConfig(
+		testAccAWSNetworkManagerLinkConfigBase(),
+		`
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  bandwidth {
+    download_speed = 50
+    upload_speed   = 10
+  }
+}
+`)
+}
+
+func testAccAWSNetworkManagerLinkConfigAllAttributes(description, providerName, linkType string, downloadSpeed, uploadSpeed int) string {
+	return composeConfig(
+		testAccAWSNetworkManagerLinkConfigBase(),
+		fmt.Sprintf(`
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+  description       = %[1]q
+  provider_name     = %[2]q
+  type              = %[3]q
+
+  bandwidth {
+    download_speed = %[4]d
+    upload_speed   = %[5]d
+  }
+}
+`, description, providerName, linkType, downloadSpeed, uploadSpeed))
+}
+
+func testAccAWSNetworkManagerLinkConfigTags1(tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSNetworkManagerLinkConfigBase(),
+		fmt.Sprintf(`
+resource "aws_networkmanager_link" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  bandwidth {
+    download_speed = 50
+    upload_speed   = 10
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
@@ -0,0 +1,294 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfnetworkmanager "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/networkmanager/waiter"
+)
+
+func resourceAwsNetworkManagerSite() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsNetworkManagerSiteCreate,
+		Read:   resourceAwsNetworkManagerSiteRead,
+		Update: resourceAwsNetworkManagerSiteUpdate,
+		Delete: resourceAwsNetworkManagerSiteDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkManagerImportStateByArn,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.AvailableTimeout),
+			Update: schema.DefaultTimeout(waiter.AvailableTimeout),
+			Delete: schema.DefaultTimeout(waiter.DeletedTimeout),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": networkManagerLocationSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsNetworkManagerSiteCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	input := &networkmanager.CreateSiteInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Location = expandNetworkManagerLocation(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().NetworkmanagerTags()
+	}
+
+	log.Printf("[DEBUG] Creating Network Manager Site: %s", input)
+	output, err := conn.CreateSite(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Network Manager Site in Global Network (%s): %w", globalNetworkID, err)
+	}
+
+	if output == nil || output.Site == nil {
+		return fmt.Errorf("error creating Network Manager Site in Global Network (%s): empty response", globalNetworkID)
+	}
+
+	d.SetId(aws.StringValue(output.Site.SiteId))
+
+	if _, err := waiter.SiteAvailable(conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Site (%s) to become available: %w", d.Id(), err)
+	}
+
+	return resourceAwsNetworkManagerSiteRead(d, meta)
+}
+
+func resourceAwsNetworkManagerSiteRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	site, err := finder.SiteByID(conn, d.Get("global_network_id").(string), d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Network Manager Site (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Network Manager Site (%s): %w", d.Id(), err)
+	}
+
+	if site == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Network Manager Site (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Network Manager Site (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", site.SiteArn)
+	d.Set("description", site.Description)
+	d.Set("global_network_id", site.GlobalNetworkId)
+
+	if err := d.Set("location", flattenNetworkManagerLocation(site.Location)); err != nil {
+		return fmt.Errorf("error setting location: %w", err)
+	}
+
+	tags := keyvaluetags.NetworkmanagerKeyValueTags(site.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsNetworkManagerSiteUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	if d.HasChanges("description", "location") {
+		globalNetworkID := d.Get("global_network_id").(string)
+
+		input := &networkmanager.UpdateSiteInput{
+			Description:     aws.String(d.Get("description").(string)),
+			GlobalNetworkId: aws.String(globalNetworkID),
+			SiteId:          aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Location = expandNetworkManagerLocation(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.Location = &networkmanager.Location{}
+		}
+
+		log.Printf("[DEBUG] Updating Network Manager Site: %s", input)
+		_, err := conn.UpdateSite(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Network Manager Site (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.SiteAvailable(conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Network Manager Site (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.NetworkmanagerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Network Manager Site (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsNetworkManagerSiteRead(d, meta)
+}
+
+func resourceAwsNetworkManagerSiteDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).networkmanagerconn
+
+	globalNetworkID := d.Get("global_network_id").(string)
+
+	log.Printf("[DEBUG] Deleting Network Manager Site: %s", d.Id())
+	_, err := conn.DeleteSite(&networkmanager.DeleteSiteInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+		SiteId:          aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Network Manager Site (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.SiteDeleted(conn, globalNetworkID, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Network Manager Site (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsNetworkManagerImportStateByArn imports a site, device or link by its ARN,
+// from which both the global network ID and the resource ID are derived.
+func resourceAwsNetworkManagerImportStateByArn(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	globalNetworkID, resourceID, err := tfnetworkmanager.ResourceIDsFromArn(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(resourceID)
+	d.Set("global_network_id", globalNetworkID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func networkManagerLocationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"latitude": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"longitude": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func expandNetworkManagerLocation(tfMap map[string]interface{}) *networkmanager.Location {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &networkmanager.Location{}
+
+	if v, ok := tfMap["address"].(string); ok && v != "" {
+		apiObject.Address = aws.String(v)
+	}
+
+	if v, ok := tfMap["latitude"].(string); ok && v != "" {
+		apiObject.Latitude = aws.String(v)
+	}
+
+	if v, ok := tfMap["longitude"].(string); ok && v != "" {
+		apiObject.Longitude = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenNetworkManagerLocation(apiObject *networkmanager.Location) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	if apiObject.Address == nil && apiObject.Latitude == nil && apiObject.Longitude == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"address":   aws.StringValue(apiObject.Address),
+		"latitude":  aws.StringValue(apiObject.Latitude),
+		"longitude": aws.StringValue(apiObject.Longitude),
+	}
+
+	return []interface{}{tfMap}
+}
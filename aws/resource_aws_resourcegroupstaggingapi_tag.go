@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/resourcegroupstaggingapi/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/resourcegroupstaggingapi/waiter"
+)
+
+func resourceAwsResourceGroupsTaggingAPITag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsResourceGroupsTaggingAPITagCreate,
+		Read:   resourceAwsResourceGroupsTaggingAPITagRead,
+		Update: resourceAwsResourceGroupsTaggingAPITagUpdate,
+		Delete: resourceAwsResourceGroupsTaggingAPITagDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+		},
+	}
+}
+
+func extractResourceARNAndKeyFromResourceGroupsTaggingAPITagID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ",", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Invalid resource ID; cannot look up resource: %s", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func resourceAwsResourceGroupsTaggingAPITagCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupstaggingapiconn
+
+	resourceARN := d.Get("resource_arn").(string)
+	key := d.Get("key").(string)
+	value := d.Get("value").(string)
+
+	if err := resourceGroupsTaggingAPITagResource(conn, resourceARN, key, value); err != nil {
+		return fmt.Errorf("error creating Resource Groups Tagging API Tag (%s) for resource (%s): %w", key, resourceARN, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", resourceARN, key))
+
+	if _, err := waiter.TagPropagated(conn, resourceARN, key, value); err != nil {
+		return fmt.Errorf("error waiting for Resource Groups Tagging API Tag (%s) for resource (%s) to propagate: %w", key, resourceARN, err)
+	}
+
+	return resourceAwsResourceGroupsTaggingAPITagRead(d, meta)
+}
+
+func resourceAwsResourceGroupsTaggingAPITagRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupstaggingapiconn
+	resourceARN, key, err := extractResourceARNAndKeyFromResourceGroupsTaggingAPITagID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	resourceTagMapping, err := finder.ResourceTagMappingByARNAndTagKey(conn, resourceARN, key)
+
+	if err != nil {
+		return fmt.Errorf("error reading Resource Groups Tagging API Tag (%s) for resource (%s): %w", key, resourceARN, err)
+	}
+
+	tags := keyvaluetags.New(nil)
+
+	if resourceTagMapping != nil {
+		tags = keyvaluetags.ResourcegroupstaggingapiKeyValueTags(resourceTagMapping.Tags)
+	}
+
+	if !tags.KeyExists(key) {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Resource Groups Tagging API Tag (%s) for resource (%s): not found after creation", key, resourceARN)
+		}
+
+		log.Printf("[WARN] Resource Groups Tagging API Tag (%s) for resource (%s) not found, removing from state", key, resourceARN)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("key", key)
+	d.Set("resource_arn", resourceARN)
+	d.Set("value", tags.KeyValue(key))
+
+	return nil
+}
+
+func resourceAwsResourceGroupsTaggingAPITagUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupstaggingapiconn
+	resourceARN, key, err := extractResourceARNAndKeyFromResourceGroupsTaggingAPITagID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	value := d.Get("value").(string)
+
+	if err := resourceGroupsTaggingAPITagResource(conn, resourceARN, key, value); err != nil {
+		return fmt.Errorf("error updating Resource Groups Tagging API Tag (%s) for resource (%s): %w", key, resourceARN, err)
+	}
+
+	if _, err := waiter.TagPropagated(conn, resourceARN, key, value); err != nil {
+		return fmt.Errorf("error waiting for Resource Groups Tagging API Tag (%s) for resource (%s) to propagate: %w", key, resourceARN, err)
+	}
+
+	return resourceAwsResourceGroupsTaggingAPITagRead(d, meta)
+}
+
+func resourceAwsResourceGroupsTaggingAPITagDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).resourcegroupstaggingapiconn
+	resourceARN, key, err := extractResourceARNAndKeyFromResourceGroupsTaggingAPITagID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &resourcegroupstaggingapi.UntagResourcesInput{
+		ResourceARNList: aws.StringSlice([]string{resourceARN}),
+		TagKeys:         aws.StringSlice([]string{key}),
+	}
+
+	log.Printf("[DEBUG] Deleting Resource Groups Tagging API Tag: %s", input)
+	output, err := conn.UntagResources(input)
+
+	if err == nil {
+		err = resourceGroupsTaggingAPIFailedResourcesError(output.FailedResourcesMap, resourceARN)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Resource Groups Tagging API Tag (%s) for resource (%s): %w", key, resourceARN, err)
+	}
+
+	return nil
+}
+
+func resourceGroupsTaggingAPITagResource(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, resourceARN, key, value string) error {
+	input := &resourcegroupstaggingapi.TagResourcesInput{
+		ResourceARNList: aws.StringSlice([]string{resourceARN}),
+		Tags:            aws.StringMap(map[string]string{key: value}),
+	}
+
+	log.Printf("[DEBUG] Tagging resource: %s", input)
+	output, err := conn.TagResources(input)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceGroupsTaggingAPIFailedResourcesError(output.FailedResourcesMap, resourceARN)
+}
+
+// resourceGroupsTaggingAPIFailedResourcesError returns an error describing why
+// the specified resource could not be tagged or untagged, if it failed.
+// TagResources and UntagResources report per-resource failures in the response
+// rather than as an API error.
+func resourceGroupsTaggingAPIFailedResourcesError(failedResources map[string]*resourcegroupstaggingapi.FailureInfo, resourceARN string) error {
+	failureInfo, ok := failedResources[resourceARN]
+
+	if !ok || failureInfo == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s", aws.StringValue(failureInfo.ErrorCode), aws.StringValue(failureInfo.ErrorMessage))
+}
@@ -0,0 +1,188 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/resourcegroupstaggingapi/finder"
+)
+
+func TestAccAWSResourceGroupsTaggingAPITag_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_resourcegroupstaggingapi_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(resourcegroupstaggingapi.EndpointsID, t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckResourceGroupsTaggingAPITagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupsTaggingAPITagConfig(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceGroupsTaggingAPITagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_sqs_queue.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSResourceGroupsTaggingAPITag_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_resourcegroupstaggingapi_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(resourcegroupstaggingapi.EndpointsID, t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckResourceGroupsTaggingAPITagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupsTaggingAPITagConfig(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceGroupsTaggingAPITagExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsResourceGroupsTaggingAPITag(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSResourceGroupsTaggingAPITag_Value(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_resourcegroupstaggingapi_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(resourcegroupstaggingapi.EndpointsID, t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckResourceGroupsTaggingAPITagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupsTaggingAPITagConfig(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceGroupsTaggingAPITagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceGroupsTaggingAPITagConfig(rName, "key1", "value1updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceGroupsTaggingAPITagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceGroupsTaggingAPITagDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).resourcegroupstaggingapiconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_resourcegroupstaggingapi_tag" {
+			continue
+		}
+
+		resourceARN, key, err := extractResourceARNAndKeyFromResourceGroupsTaggingAPITagID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		resourceTagMapping, err := finder.ResourceTagMappingByARNAndTagKey(conn, resourceARN, key)
+
+		if err != nil {
+			return err
+		}
+
+		if resourceTagMapping == nil {
+			continue
+		}
+
+		if keyvaluetags.ResourcegroupstaggingapiKeyValueTags(resourceTagMapping.Tags).KeyExists(key) {
+			return fmt.Errorf("Tag (%s) for resource (%s) still exists", key, resourceARN)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckResourceGroupsTaggingAPITagExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("%s: missing resource ID", resourceName)
+		}
+
+		resourceARN, key, err := extractResourceARNAndKeyFromResourceGroupsTaggingAPITagID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).resourcegroupstaggingapiconn
+
+		resourceTagMapping, err := finder.ResourceTagMappingByARNAndTagKey(conn, resourceARN, key)
+
+		if err != nil {
+			return err
+		}
+
+		if resourceTagMapping == nil || !keyvaluetags.ResourcegroupstaggingapiKeyValueTags(resourceTagMapping.Tags).KeyExists(key) {
+			return fmt.Errorf("Tag (%s) for resource (%s) not found", key, resourceARN)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceGroupsTaggingAPITagConfig(rName, key, value string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_resourcegroupstaggingapi_tag" "test" {
+  resource_arn = aws_sqs_queue.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -0,0 +1,841 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53domains/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53domains/waiter"
+)
+
+func resourceAwsRoute53DomainsRegisteredDomain() *schema.Resource {
+	contactSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address_line_1": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"address_line_2": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"city": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"contact_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(route53domains.ContactType_Values(), false),
+				},
+				"country_code": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(route53domains.CountryCode_Values(), false),
+				},
+				"email": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringLenBetween(0, 254),
+				},
+				"extra_params": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"fax": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringLenBetween(0, 30),
+				},
+				"first_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"last_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"organization_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"phone_number": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringLenBetween(0, 30),
+				},
+				"state": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+				"zip_code": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringLenBetween(0, 255),
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceAwsRoute53DomainsRegisteredDomainCreate,
+		Read:   resourceAwsRoute53DomainsRegisteredDomainRead,
+		Update: resourceAwsRoute53DomainsRegisteredDomainUpdate,
+		Delete: resourceAwsRoute53DomainsRegisteredDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"abuse_contact_email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"abuse_contact_phone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_contact": contactSchema,
+			"admin_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"auto_renew": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name_server": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 6,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"glue_ips": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 2,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsIPAddress,
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+					},
+				},
+			},
+			"registrant_contact": contactSchema,
+			"registrant_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"registrar_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registrar_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reseller": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":         tagsSchema(),
+			"tags_all":     tagsSchemaTrulyComputed(),
+			"tech_contact": contactSchema,
+			"tech_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"transfer_lock": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"whois_server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53DomainsRegisteredDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53domainsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	// The domain must already be registered; it is adopted rather than created.
+	domainName := d.Get("domain_name").(string)
+	domainDetail, err := finder.DomainDetailByName(conn, domainName)
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Domains Domain (%s): %w", domainName, err)
+	}
+
+	if domainDetail == nil {
+		return fmt.Errorf("error reading Route 53 Domains Domain (%s): not found", domainName)
+	}
+
+	d.SetId(aws.StringValue(domainDetail.DomainName))
+
+	if v, ok := d.GetOk("admin_contact"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if adminContact := expandRoute53DomainsContactDetail(v.([]interface{})[0].(map[string]interface{})); !route53DomainsContactDetailEqual(adminContact, domainDetail.AdminContact) {
+			if err := modifyRoute53DomainsDomainContacts(conn, d.Id(), adminContact, nil, nil, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("registrant_contact"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if registrantContact := expandRoute53DomainsContactDetail(v.([]interface{})[0].(map[string]interface{})); !route53DomainsContactDetailEqual(registrantContact, domainDetail.RegistrantContact) {
+			if err := modifyRoute53DomainsDomainContacts(conn, d.Id(), nil, registrantContact, nil, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("tech_contact"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if techContact := expandRoute53DomainsContactDetail(v.([]interface{})[0].(map[string]interface{})); !route53DomainsContactDetailEqual(techContact, domainDetail.TechContact) {
+			if err := modifyRoute53DomainsDomainContacts(conn, d.Id(), nil, nil, techContact, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if adminPrivacy, registrantPrivacy, techPrivacy := d.Get("admin_privacy").(bool), d.Get("registrant_privacy").(bool), d.Get("tech_privacy").(bool); adminPrivacy != aws.BoolValue(domainDetail.AdminPrivacy) || registrantPrivacy != aws.BoolValue(domainDetail.RegistrantPrivacy) || techPrivacy != aws.BoolValue(domainDetail.TechPrivacy) {
+		if err := modifyRoute53DomainsDomainContactPrivacy(conn, d.Id(), adminPrivacy, registrantPrivacy, techPrivacy, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	if v := d.Get("auto_renew").(bool); v != aws.BoolValue(domainDetail.AutoRenew) {
+		if err := modifyRoute53DomainsDomainAutoRenew(conn, d.Id(), v); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("name_server"); ok && len(v.([]interface{})) > 0 {
+		nameservers := expandRoute53DomainsNameservers(v.([]interface{}))
+
+		if !route53DomainsNameserversEqual(nameservers, domainDetail.Nameservers) {
+			if err := modifyRoute53DomainsDomainNameservers(conn, d.Id(), nameservers, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v := d.Get("transfer_lock").(bool); v != hasRoute53DomainsTransferLock(aws.StringValueSlice(domainDetail.StatusList)) {
+		if err := modifyRoute53DomainsDomainTransferLock(conn, d.Id(), v, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	tags, err := keyvaluetags.Route53domainsListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Route 53 Domains Domain (%s): %w", d.Id(), err)
+	}
+
+	oldTags := tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+	newTags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{}))).IgnoreConfig(ignoreTagsConfig)
+
+	if err := keyvaluetags.Route53domainsUpdateTags(conn, d.Id(), oldTags, newTags); err != nil {
+		return fmt.Errorf("error updating Route 53 Domains Domain (%s) tags: %w", d.Id(), err)
+	}
+
+	return resourceAwsRoute53DomainsRegisteredDomainRead(d, meta)
+}
+
+func resourceAwsRoute53DomainsRegisteredDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53domainsconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	domainDetail, err := finder.DomainDetailByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, route53domains.ErrCodeInvalidInput, "not found") {
+		log.Printf("[WARN] Route 53 Domains Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route 53 Domains Domain (%s): %w", d.Id(), err)
+	}
+
+	if domainDetail == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Route 53 Domains Domain (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Route 53 Domains Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("abuse_contact_email", domainDetail.AbuseContactEmail)
+	d.Set("abuse_contact_phone", domainDetail.AbuseContactPhone)
+
+	if domainDetail.AdminContact != nil {
+		if err := d.Set("admin_contact", []interface{}{flattenRoute53DomainsContactDetail(domainDetail.AdminContact)}); err != nil {
+			return fmt.Errorf("error setting admin_contact: %w", err)
+		}
+	} else {
+		d.Set("admin_contact", nil)
+	}
+
+	d.Set("admin_privacy", domainDetail.AdminPrivacy)
+	d.Set("auto_renew", domainDetail.AutoRenew)
+
+	if domainDetail.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(domainDetail.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+
+	d.Set("domain_name", domainDetail.DomainName)
+
+	if domainDetail.ExpirationDate != nil {
+		d.Set("expiration_date", aws.TimeValue(domainDetail.ExpirationDate).Format(time.RFC3339))
+	} else {
+		d.Set("expiration_date", nil)
+	}
+
+	if err := d.Set("name_server", flattenRoute53DomainsNameservers(domainDetail.Nameservers)); err != nil {
+		return fmt.Errorf("error setting name_server: %w", err)
+	}
+
+	if domainDetail.RegistrantContact != nil {
+		if err := d.Set("registrant_contact", []interface{}{flattenRoute53DomainsContactDetail(domainDetail.RegistrantContact)}); err != nil {
+			return fmt.Errorf("error setting registrant_contact: %w", err)
+		}
+	} else {
+		d.Set("registrant_contact", nil)
+	}
+
+	d.Set("registrant_privacy", domainDetail.RegistrantPrivacy)
+	d.Set("registrar_name", domainDetail.RegistrarName)
+	d.Set("registrar_url", domainDetail.RegistrarUrl)
+	d.Set("reseller", domainDetail.Reseller)
+
+	statusList := aws.StringValueSlice(domainDetail.StatusList)
+	d.Set("status_list", statusList)
+
+	if domainDetail.TechContact != nil {
+		if err := d.Set("tech_contact", []interface{}{flattenRoute53DomainsContactDetail(domainDetail.TechContact)}); err != nil {
+			return fmt.Errorf("error setting tech_contact: %w", err)
+		}
+	} else {
+		d.Set("tech_contact", nil)
+	}
+
+	d.Set("tech_privacy", domainDetail.TechPrivacy)
+	d.Set("transfer_lock", hasRoute53DomainsTransferLock(statusList))
+
+	if domainDetail.UpdatedDate != nil {
+		d.Set("updated_date", aws.TimeValue(domainDetail.UpdatedDate).Format(time.RFC3339))
+	} else {
+		d.Set("updated_date", nil)
+	}
+
+	d.Set("whois_server", domainDetail.WhoIsServer)
+
+	tags, err := keyvaluetags.Route53domainsListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Route 53 Domains Domain (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRoute53DomainsRegisteredDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).route53domainsconn
+
+	if d.HasChanges("admin_contact", "registrant_contact", "tech_contact") {
+		var adminContact, registrantContact, techContact *route53domains.ContactDetail
+
+		if key := "admin_contact"; d.HasChange(key) {
+			if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				adminContact = expandRoute53DomainsContactDetail(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if key := "registrant_contact"; d.HasChange(key) {
+			if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				registrantContact = expandRoute53DomainsContactDetail(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if key := "tech_contact"; d.HasChange(key) {
+			if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				techContact = expandRoute53DomainsContactDetail(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if adminContact != nil || registrantContact != nil || techContact != nil {
+			if err := modifyRoute53DomainsDomainContacts(conn, d.Id(), adminContact, registrantContact, techContact, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChanges("admin_privacy", "registrant_privacy", "tech_privacy") {
+		if err := modifyRoute53DomainsDomainContactPrivacy(conn, d.Id(), d.Get("admin_privacy").(bool), d.Get("registrant_privacy").(bool), d.Get("tech_privacy").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("auto_renew") {
+		if err := modifyRoute53DomainsDomainAutoRenew(conn, d.Id(), d.Get("auto_renew").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("name_server") {
+		if v, ok := d.GetOk("name_server"); ok && len(v.([]interface{})) > 0 {
+			if err := modifyRoute53DomainsDomainNameservers(conn, d.Id(), expandRoute53DomainsNameservers(v.([]interface{})), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("transfer_lock") {
+		if err := modifyRoute53DomainsDomainTransferLock(conn, d.Id(), d.Get("transfer_lock").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Route53domainsUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Route 53 Domains Domain (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRoute53DomainsRegisteredDomainRead(d, meta)
+}
+
+func resourceAwsRoute53DomainsRegisteredDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Route 53 Domains Domain (%s) not deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func modifyRoute53DomainsDomainAutoRenew(conn *route53domains.Route53Domains, domainName string, autoRenew bool) error {
+	if autoRenew {
+		input := &route53domains.EnableDomainAutoRenewInput{
+			DomainName: aws.String(domainName),
+		}
+
+		log.Printf("[DEBUG] Enabling Route 53 Domains Domain auto-renew: %s", input)
+		_, err := conn.EnableDomainAutoRenew(input)
+
+		if err != nil {
+			return fmt.Errorf("error enabling Route 53 Domains Domain (%s) auto-renew: %w", domainName, err)
+		}
+	} else {
+		input := &route53domains.DisableDomainAutoRenewInput{
+			DomainName: aws.String(domainName),
+		}
+
+		log.Printf("[DEBUG] Disabling Route 53 Domains Domain auto-renew: %s", input)
+		_, err := conn.DisableDomainAutoRenew(input)
+
+		if err != nil {
+			return fmt.Errorf("error disabling Route 53 Domains Domain (%s) auto-renew: %w", domainName, err)
+		}
+	}
+
+	return nil
+}
+
+func modifyRoute53DomainsDomainContacts(conn *route53domains.Route53Domains, domainName string, adminContact, registrantContact, techContact *route53domains.ContactDetail, timeout time.Duration) error {
+	input := &route53domains.UpdateDomainContactInput{
+		AdminContact:      adminContact,
+		DomainName:        aws.String(domainName),
+		RegistrantContact: registrantContact,
+		TechContact:       techContact,
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Domains Domain contacts: %s", domainName)
+	output, err := conn.UpdateDomainContact(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Route 53 Domains Domain (%s) contacts: %w", domainName, err)
+	}
+
+	if _, err := waiter.OperationSucceeded(conn, aws.StringValue(output.OperationId), timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) contacts update: %w", domainName, err)
+	}
+
+	return nil
+}
+
+func modifyRoute53DomainsDomainContactPrivacy(conn *route53domains.Route53Domains, domainName string, adminPrivacy, registrantPrivacy, techPrivacy bool, timeout time.Duration) error {
+	input := &route53domains.UpdateDomainContactPrivacyInput{
+		AdminPrivacy:      aws.Bool(adminPrivacy),
+		DomainName:        aws.String(domainName),
+		RegistrantPrivacy: aws.Bool(registrantPrivacy),
+		TechPrivacy:       aws.Bool(techPrivacy),
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Domains Domain contact privacy: %s", input)
+	output, err := conn.UpdateDomainContactPrivacy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Route 53 Domains Domain (%s) contact privacy: %w", domainName, err)
+	}
+
+	if _, err := waiter.OperationSucceeded(conn, aws.StringValue(output.OperationId), timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) contact privacy update: %w", domainName, err)
+	}
+
+	return nil
+}
+
+func modifyRoute53DomainsDomainNameservers(conn *route53domains.Route53Domains, domainName string, nameservers []*route53domains.Nameserver, timeout time.Duration) error {
+	input := &route53domains.UpdateDomainNameserversInput{
+		DomainName:  aws.String(domainName),
+		Nameservers: nameservers,
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Domains Domain name servers: %s", input)
+	output, err := conn.UpdateDomainNameservers(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Route 53 Domains Domain (%s) name servers: %w", domainName, err)
+	}
+
+	if _, err := waiter.OperationSucceeded(conn, aws.StringValue(output.OperationId), timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) name servers update: %w", domainName, err)
+	}
+
+	return nil
+}
+
+func modifyRoute53DomainsDomainTransferLock(conn *route53domains.Route53Domains, domainName string, transferLock bool, timeout time.Duration) error {
+	var operationID string
+
+	if transferLock {
+		input := &route53domains.EnableDomainTransferLockInput{
+			DomainName: aws.String(domainName),
+		}
+
+		log.Printf("[DEBUG] Enabling Route 53 Domains Domain transfer lock: %s", input)
+		output, err := conn.EnableDomainTransferLock(input)
+
+		if err != nil {
+			return fmt.Errorf("error enabling Route 53 Domains Domain (%s) transfer lock: %w", domainName, err)
+		}
+
+		operationID = aws.StringValue(output.OperationId)
+	} else {
+		input := &route53domains.DisableDomainTransferLockInput{
+			DomainName: aws.String(domainName),
+		}
+
+		log.Printf("[DEBUG] Disabling Route 53 Domains Domain transfer lock: %s", input)
+		output, err := conn.DisableDomainTransferLock(input)
+
+		if err != nil {
+			return fmt.Errorf("error disabling Route 53 Domains Domain (%s) transfer lock: %w", domainName, err)
+		}
+
+		operationID = aws.StringValue(output.OperationId)
+	}
+
+	if _, err := waiter.OperationSucceeded(conn, operationID, timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) transfer lock update: %w", domainName, err)
+	}
+
+	return nil
+}
+
+// hasRoute53DomainsTransferLock returns whether the domain status list
+// contains the EPP status code indicating that transfers are prohibited.
+func hasRoute53DomainsTransferLock(statusList []string) bool {
+	for _, v := range statusList {
+		if v == "clientTransferProhibited" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandRoute53DomainsContactDetail(tfMap map[string]interface{}) *route53domains.ContactDetail {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &route53domains.ContactDetail{}
+
+	if v, ok := tfMap["address_line_1"].(string); ok && v != "" {
+		apiObject.AddressLine1 = aws.String(v)
+	}
+
+	if v, ok := tfMap["address_line_2"].(string); ok && v != "" {
+		apiObject.AddressLine2 = aws.String(v)
+	}
+
+	if v, ok := tfMap["city"].(string); ok && v != "" {
+		apiObject.City = aws.String(v)
+	}
+
+	if v, ok := tfMap["contact_type"].(string); ok && v != "" {
+		apiObject.ContactType = aws.String(v)
+	}
+
+	if v, ok := tfMap["country_code"].(string); ok && v != "" {
+		apiObject.CountryCode = aws.String(v)
+	}
+
+	if v, ok := tfMap["email"].(string); ok && v != "" {
+		apiObject.Email = aws.String(v)
+	}
+
+	if v, ok := tfMap["extra_params"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.ExtraParams = expandRoute53DomainsExtraParams(v)
+	}
+
+	if v, ok := tfMap["fax"].(string); ok && v != "" {
+		apiObject.Fax = aws.String(v)
+	}
+
+	if v, ok := tfMap["first_name"].(string); ok && v != "" {
+		apiObject.FirstName = aws.String(v)
+	}
+
+	if v, ok := tfMap["last_name"].(string); ok && v != "" {
+		apiObject.LastName = aws.String(v)
+	}
+
+	if v, ok := tfMap["organization_name"].(string); ok && v != "" {
+		apiObject.OrganizationName = aws.String(v)
+	}
+
+	if v, ok := tfMap["phone_number"].(string); ok && v != "" {
+		apiObject.PhoneNumber = aws.String(v)
+	}
+
+	if v, ok := tfMap["state"].(string); ok && v != "" {
+		apiObject.State = aws.String(v)
+	}
+
+	if v, ok := tfMap["zip_code"].(string); ok && v != "" {
+		apiObject.ZipCode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRoute53DomainsExtraParams(tfMap map[string]interface{}) []*route53domains.ExtraParam {
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	var apiObjects []*route53domains.ExtraParam
+
+	for k, v := range tfMap {
+		apiObjects = append(apiObjects, &route53domains.ExtraParam{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandRoute53DomainsNameservers(tfList []interface{}) []*route53domains.Nameserver {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*route53domains.Nameserver
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &route53domains.Nameserver{}
+
+		if v, ok := tfMap["glue_ips"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.GlueIps = expandStringSet(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenRoute53DomainsContactDetail(apiObject *route53domains.ContactDetail) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"address_line_1":    aws.StringValue(apiObject.AddressLine1),
+		"address_line_2":    aws.StringValue(apiObject.AddressLine2),
+		"city":              aws.StringValue(apiObject.City),
+		"contact_type":      aws.StringValue(apiObject.ContactType),
+		"country_code":      aws.StringValue(apiObject.CountryCode),
+		"email":             aws.StringValue(apiObject.Email),
+		"extra_params":      flattenRoute53DomainsExtraParams(apiObject.ExtraParams),
+		"fax":               aws.StringValue(apiObject.Fax),
+		"first_name":        aws.StringValue(apiObject.FirstName),
+		"last_name":         aws.StringValue(apiObject.LastName),
+		"organization_name": aws.StringValue(apiObject.OrganizationName),
+		"phone_number":      aws.StringValue(apiObject.PhoneNumber),
+		"state":             aws.StringValue(apiObject.State),
+		"zip_code":          aws.StringValue(apiObject.ZipCode),
+	}
+
+	return tfMap
+}
+
+func flattenRoute53DomainsExtraParams(apiObjects []*route53domains.ExtraParam) map[string]interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap[aws.StringValue(apiObject.Name)] = aws.StringValue(apiObject.Value)
+	}
+
+	return tfMap
+}
+
+func flattenRoute53DomainsNameservers(apiObjects []*route53domains.Nameserver) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"glue_ips": aws.StringValueSlice(apiObject.GlueIps),
+			"name":     aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+// route53DomainsContactDetailEqual compares the configured contact with the
+// current contact using their flattened representations.
+func route53DomainsContactDetailEqual(a, b *route53domains.ContactDetail) bool {
+	return reflect.DeepEqual(flattenRoute53DomainsContactDetail(a), flattenRoute53DomainsContactDetail(b))
+}
+
+// route53DomainsNameserversEqual compares the configured name servers with the
+// current name servers using their flattened representations.
+func route53DomainsNameserversEqual(a, b []*route53domains.Nameserver) bool {
+	return reflect.DeepEqual(flattenRoute53DomainsNameservers(a), flattenRoute53DomainsNameservers(b))
+}
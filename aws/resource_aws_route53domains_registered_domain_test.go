@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// These tests modify a single, pre-existing registered domain and therefore cannot run in parallel.
+
+func testAccPreCheckRoute53DomainsRegisteredDomain(t *testing.T) string {
+	domainName := os.Getenv("ROUTE53DOMAINS_DOMAIN_NAME")
+
+	if domainName == "" {
+		t.Skip("Environment variable ROUTE53DOMAINS_DOMAIN_NAME is not set")
+	}
+
+	return domainName
+}
+
+func TestAccAWSRoute53DomainsRegisteredDomain_tags(t *testing.T) {
+	domainName := testAccPreCheckRoute53DomainsRegisteredDomain(t)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(route53domains.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigTags1(domainName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigTags2(domainName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigTags1(domainName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53DomainsRegisteredDomain_AutoRenew(t *testing.T) {
+	domainName := testAccPreCheckRoute53DomainsRegisteredDomain(t)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(route53domains.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigAutoRenew(domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "false"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigAutoRenew(domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53DomainsRegisteredDomain_ContactPrivacy(t *testing.T) {
+	domainName := testAccPreCheckRoute53DomainsRegisteredDomain(t)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(route53domains.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName, true, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "true"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName, true, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53DomainsRegisteredDomain_NameServers(t *testing.T) {
+	domainName := testAccPreCheckRoute53DomainsRegisteredDomain(t)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(route53domains.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigNameServers(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name_server.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "name_server.0.glue_ips.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name_server.0.name", "ns1.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "name_server.1.glue_ips.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name_server.1.name", fmt.Sprintf("ns2.%s", domainName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53DomainsRegisteredDomain_TransferLock(t *testing.T) {
+	domainName := testAccPreCheckRoute53DomainsRegisteredDomain(t)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(route53domains.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53DomainsRegisteredDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigTransferLock(domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "false"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53DomainsRegisteredDomainConfigTransferLock(domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRoute53DomainsRegisteredDomainDestroy(s *terraform.State) error {
+	// We expect the domain to still be registered
+	return nil
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigTags1(domainName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, domainName, tagKey1, tagValue1)
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigTags2(domainName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, domainName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigAutoRenew(domainName string, autoRenew bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+  auto_renew  = %[2]t
+}
+`, domainName, autoRenew)
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName string, adminPrivacy, registrantPrivacy, techPrivacy bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  admin_privacy      = %[2]t
+  registrant_privacy = %[3]t
+  tech_privacy       = %[4]t
+}
+`, domainName, adminPrivacy, registrantPrivacy, techPrivacy)
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigNameServers(domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  name_server {
+    name = "ns1.example.com"
+  }
+
+  name_server {
+    name     = "ns2.%[1]s"
+    glue_ips = ["2.3.4.5"]
+  }
+}
+`, domainName)
+}
+
+func testAccAWSRoute53DomainsRegisteredDomainConfigTransferLock(domainName string, transferLock bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name   = %[1]q
+  transfer_lock = %[2]t
+}
+`, domainName, transferLock)
+}
@@ -0,0 +1,339 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+const (
+	serverlessApplicationRepositoryCloudFormationStackNamePrefix = "serverlessrepo-"
+
+	serverlessApplicationRepositoryCloudFormationStackTagApplicationID   = "serverlessrepo:applicationId"
+	serverlessApplicationRepositoryCloudFormationStackTagSemanticVersion = "serverlessrepo:semanticVersion"
+)
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStack() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServerlessApplicationRepositoryCloudFormationStackCreate,
+		Read:   resourceAwsServerlessApplicationRepositoryCloudFormationStackRead,
+		Update: resourceAwsServerlessApplicationRepositoryCloudFormationStackUpdate,
+		Delete: resourceAwsServerlessApplicationRepositoryCloudFormationStackDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(serverlessapplicationrepository.Capability_Values(), false),
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"semantic_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStackCreate(d *schema.ResourceData, meta interface{}) error {
+	cfConn := meta.(*AWSClient).cfconn
+
+	changeSet, err := resourceAwsServerlessApplicationRepositoryCloudFormationStackCreateChangeSet(d, meta, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error creating Serverless Application Repository CloudFormation Stack (%s) change set: %w", d.Get("name").(string), err)
+	}
+
+	if aws.StringValue(changeSet.Status) == cloudformation.ChangeSetStatusFailed {
+		return fmt.Errorf("error creating Serverless Application Repository CloudFormation Stack (%s) change set: %s", d.Get("name").(string), aws.StringValue(changeSet.StatusReason))
+	}
+
+	d.SetId(aws.StringValue(changeSet.StackId))
+
+	log.Printf("[DEBUG] Executing Serverless Application Repository CloudFormation Stack change set: %s", aws.StringValue(changeSet.ChangeSetId))
+	_, err = cfConn.ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+		ChangeSetName: changeSet.ChangeSetId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error executing Serverless Application Repository CloudFormation Stack (%s) change set: %w", d.Id(), err)
+	}
+
+	status, err := waitForCloudFormationStackCreation(cfConn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Serverless Application Repository CloudFormation Stack (%s) creation: %w", d.Id(), err)
+	}
+
+	if status != cloudformation.StackStatusCreateComplete {
+		reasons, err := getCloudFormationRollbackReasons(d.Id(), nil, cfConn)
+
+		if err != nil {
+			return fmt.Errorf("error getting Serverless Application Repository CloudFormation Stack (%s) failure reasons: %w", d.Id(), err)
+		}
+
+		return fmt.Errorf("error creating Serverless Application Repository CloudFormation Stack (%s): %s: %q", d.Id(), status, reasons)
+	}
+
+	return resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d, meta)
+}
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d *schema.ResourceData, meta interface{}) error {
+	cfConn := meta.(*AWSClient).cfconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := cfConn.DescribeStacks(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(d.Id()),
+	})
+
+	// ValidationError: Stack with id % does not exist
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "ValidationError") {
+		log.Printf("[WARN] Serverless Application Repository CloudFormation Stack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Serverless Application Repository CloudFormation Stack (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || len(output.Stacks) == 0 || output.Stacks[0] == nil || aws.StringValue(output.Stacks[0].StackStatus) == cloudformation.StackStatusDeleteComplete {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Serverless Application Repository CloudFormation Stack (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Serverless Application Repository CloudFormation Stack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	stack := output.Stacks[0]
+
+	// Serverless Application Repo prefixes the stack name with "serverlessrepo-",
+	// so remove it from the saved string
+	d.Set("name", strings.TrimPrefix(aws.StringValue(stack.StackName), serverlessApplicationRepositoryCloudFormationStackNamePrefix))
+
+	stackTags := keyvaluetags.CloudformationKeyValueTags(stack.Tags)
+
+	if v, ok := stackTags[serverlessApplicationRepositoryCloudFormationStackTagApplicationID]; ok && v != nil {
+		d.Set("application_id", v)
+	}
+
+	if v, ok := stackTags[serverlessApplicationRepositoryCloudFormationStackTagSemanticVersion]; ok && v != nil {
+		d.Set("semantic_version", v)
+	}
+
+	tags := stackTags.IgnoreServerlessApplicationRepository().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	if err := d.Set("parameters", flattenAllCloudFormationParameters(stack.Parameters)); err != nil {
+		return fmt.Errorf("error setting parameters: %w", err)
+	}
+
+	if err := d.Set("outputs", flattenCloudFormationOutputs(stack.Outputs)); err != nil {
+		return fmt.Errorf("error setting outputs: %w", err)
+	}
+
+	// CAPABILITY_RESOURCE_POLICY is only meaningful to the Serverless Application Repository
+	// and is not reported by CloudFormation, so preserve it from configuration.
+	capabilities := flattenStringSet(stack.Capabilities)
+
+	if v, ok := d.GetOk("capabilities"); ok && v.(*schema.Set).Contains(serverlessapplicationrepository.CapabilityCapabilityResourcePolicy) {
+		capabilities.Add(serverlessapplicationrepository.CapabilityCapabilityResourcePolicy)
+	}
+
+	if err := d.Set("capabilities", capabilities); err != nil {
+		return fmt.Errorf("error setting capabilities: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStackUpdate(d *schema.ResourceData, meta interface{}) error {
+	cfConn := meta.(*AWSClient).cfconn
+
+	changeSet, err := resourceAwsServerlessApplicationRepositoryCloudFormationStackCreateChangeSet(d, meta, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("error creating Serverless Application Repository CloudFormation Stack (%s) change set: %w", d.Id(), err)
+	}
+
+	if aws.StringValue(changeSet.Status) == cloudformation.ChangeSetStatusFailed {
+		// Tag-only changes are not reflected in the stack template and may result in an empty change set.
+		if strings.Contains(aws.StringValue(changeSet.StatusReason), "didn't contain changes") {
+			return resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d, meta)
+		}
+
+		return fmt.Errorf("error creating Serverless Application Repository CloudFormation Stack (%s) change set: %s", d.Id(), aws.StringValue(changeSet.StatusReason))
+	}
+
+	log.Printf("[DEBUG] Executing Serverless Application Repository CloudFormation Stack change set: %s", aws.StringValue(changeSet.ChangeSetId))
+	_, err = cfConn.ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+		ChangeSetName: changeSet.ChangeSetId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error executing Serverless Application Repository CloudFormation Stack (%s) change set: %w", d.Id(), err)
+	}
+
+	status, err := waitForCloudFormationStackUpdate(cfConn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Serverless Application Repository CloudFormation Stack (%s) update: %w", d.Id(), err)
+	}
+
+	if status != cloudformation.StackStatusUpdateComplete {
+		reasons, err := getCloudFormationRollbackReasons(d.Id(), nil, cfConn)
+
+		if err != nil {
+			return fmt.Errorf("error getting Serverless Application Repository CloudFormation Stack (%s) failure reasons: %w", d.Id(), err)
+		}
+
+		return fmt.Errorf("error updating Serverless Application Repository CloudFormation Stack (%s): %s: %q", d.Id(), status, reasons)
+	}
+
+	return resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d, meta)
+}
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStackDelete(d *schema.ResourceData, meta interface{}) error {
+	cfConn := meta.(*AWSClient).cfconn
+
+	log.Printf("[DEBUG] Deleting Serverless Application Repository CloudFormation Stack: %s", d.Id())
+	_, err := cfConn.DeleteStack(&cloudformation.DeleteStackInput{
+		StackName: aws.String(d.Id()),
+	})
+
+	// ValidationError: Stack with id % does not exist
+	if tfawserr.ErrCodeEquals(err, "ValidationError") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Serverless Application Repository CloudFormation Stack (%s): %w", d.Id(), err)
+	}
+
+	if err := waitForCloudFormationStackDeletion(cfConn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Serverless Application Repository CloudFormation Stack (%s) deletion: %w", d.Id(), err)
+	}
+
+	_, status, err := cfStackStateRefresh(cfConn, d.Id())()
+
+	if err != nil {
+		return fmt.Errorf("error reading Serverless Application Repository CloudFormation Stack (%s): %w", d.Id(), err)
+	}
+
+	if status == cloudformation.StackStatusDeleteFailed {
+		reasons, err := getCloudFormationFailures(d.Id(), cfConn)
+
+		if err != nil {
+			return fmt.Errorf("error getting Serverless Application Repository CloudFormation Stack (%s) failure reasons: %w", d.Id(), err)
+		}
+
+		return fmt.Errorf("error deleting Serverless Application Repository CloudFormation Stack (%s): %s: %q", d.Id(), status, reasons)
+	}
+
+	return nil
+}
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStackCreateChangeSet(d *schema.ResourceData, meta interface{}, timeout time.Duration) (*cloudformation.DescribeChangeSetOutput, error) {
+	conn := meta.(*AWSClient).serverlessapplicationrepositoryconn
+	cfConn := meta.(*AWSClient).cfconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &serverlessapplicationrepository.CreateCloudFormationChangeSetRequest{
+		ApplicationId: aws.String(d.Get("application_id").(string)),
+		Capabilities:  expandStringSet(d.Get("capabilities").(*schema.Set)),
+		StackName:     aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		input.ParameterOverrides = expandServerlessApplicationRepositoryCloudFormationStackParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("semantic_version"); ok {
+		input.SemanticVersion = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreServerlessApplicationRepository().ServerlessapplicationrepositoryTags()
+	}
+
+	log.Printf("[DEBUG] Creating Serverless Application Repository CloudFormation Stack change set: %s", input)
+	output, err := conn.CreateCloudFormationChangeSet(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return waitForCloudFormationChangeSetCreation(cfConn, aws.StringValue(output.ChangeSetId), timeout)
+}
+
+func expandServerlessApplicationRepositoryCloudFormationStackParameters(tfMap map[string]interface{}) []*serverlessapplicationrepository.ParameterValue {
+	var apiObjects []*serverlessapplicationrepository.ParameterValue
+
+	for k, v := range tfMap {
+		apiObjects = append(apiObjects, &serverlessapplicationrepository.ParameterValue{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
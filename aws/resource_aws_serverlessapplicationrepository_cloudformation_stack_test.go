@@ -0,0 +1,258 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Since aws_serverlessapplicationrepository_cloudformation_stack creates CloudFormation stacks,
+// the aws_cloudformation_stack sweeper will clean these up as well.
+
+const testAccAwsServerlessApplicationRepositoryCloudFormationStackApplicationID = "arn:aws:serverlessrepo:us-east-1:297356227824:applications/SecretsManagerRDSPostgreSQLRotationSingleUser"
+
+func TestAccAwsServerlessApplicationRepositoryCloudFormationStack_basic(t *testing.T) {
+	var stack cloudformation.Stack
+	stackName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(serverlessapplicationrepository.EndpointsID, t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServerlessApplicationRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServerlessApplicationRepositoryCloudFormationStackConfig(stackName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "name", stackName),
+					resource.TestCheckResourceAttr(resourceName, "application_id", testAccAwsServerlessApplicationRepositoryCloudFormationStackApplicationID),
+					resource.TestCheckResourceAttrSet(resourceName, "semantic_version"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.functionName", fmt.Sprintf("func-%s", stackName)),
+					resource.TestCheckResourceAttr(resourceName, "outputs.%", "1"),
+					resource.TestMatchResourceAttr(resourceName, "outputs.RotationLambdaARN", regexp.MustCompile(fmt.Sprintf(`:function:func-%s$`, stackName))),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", "CAPABILITY_IAM"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", "CAPABILITY_RESOURCE_POLICY"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// CAPABILITY_RESOURCE_POLICY is not reported by CloudFormation.
+				ImportStateVerifyIgnore: []string{"capabilities"},
+			},
+		},
+	})
+}
+
+func TestAccAwsServerlessApplicationRepositoryCloudFormationStack_disappears(t *testing.T) {
+	var stack cloudformation.Stack
+	stackName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(serverlessapplicationrepository.EndpointsID, t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServerlessApplicationRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServerlessApplicationRepositoryCloudFormationStackConfig(stackName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServerlessApplicationRepositoryCloudFormationStack(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsServerlessApplicationRepositoryCloudFormationStack_update(t *testing.T) {
+	var stack cloudformation.Stack
+	stackName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPartitionHasServicePreCheck(serverlessapplicationrepository.EndpointsID, t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServerlessApplicationRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServerlessApplicationRepositoryCloudFormationStackConfigUpdateInitial(stackName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "parameters.functionName", fmt.Sprintf("func-%s", stackName)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsServerlessApplicationRepositoryCloudFormationStackConfigUpdated(stackName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "parameters.functionName", fmt.Sprintf("func-updated-%s", stackName)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServerlessApplicationRepositoryCloudFormationStackExists(resourceName string, v *cloudformation.Stack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		output, err := conn.DescribeStacks(&cloudformation.DescribeStacksInput{
+			StackName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || len(output.Stacks) == 0 {
+			return fmt.Errorf("Serverless Application Repository CloudFormation Stack (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output.Stacks[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAwsServerlessApplicationRepositoryCloudFormationStackDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_serverlessapplicationrepository_cloudformation_stack" {
+			continue
+		}
+
+		output, err := conn.DescribeStacks(&cloudformation.DescribeStacksInput{
+			StackName: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, "ValidationError") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, stack := range output.Stacks {
+			if aws.StringValue(stack.StackStatus) != cloudformation.StackStatusDeleteComplete {
+				return fmt.Errorf("Serverless Application Repository CloudFormation Stack (%s) still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsServerlessApplicationRepositoryCloudFormationStackConfig(stackName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "test" {
+  name           = %[1]q
+  application_id = %[2]q
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+}
+`, stackName, testAccAwsServerlessApplicationRepositoryCloudFormationStackApplicationID)
+}
+
+func testAccAwsServerlessApplicationRepositoryCloudFormationStackConfigUpdateInitial(stackName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "test" {
+  name           = %[1]q
+  application_id = %[2]q
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, stackName, testAccAwsServerlessApplicationRepositoryCloudFormationStackApplicationID, tagKey1, tagValue1)
+}
+
+func testAccAwsServerlessApplicationRepositoryCloudFormationStackConfigUpdated(stackName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "test" {
+  name           = %[1]q
+  application_id = %[2]q
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "func-updated-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, stackName, testAccAwsServerlessApplicationRepositoryCloudFormationStackApplicationID, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,197 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfssoadmin "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin/waiter"
+)
+
+func resourceAwsSsoAdminAccountAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSsoAdminAccountAssignmentCreate,
+		Read:   resourceAwsSsoAdminAccountAssignmentRead,
+		Delete: resourceAwsSsoAdminAccountAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"permission_set_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"principal_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 47),
+					validation.StringMatch(regexp.MustCompile(`^([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}$`), "must match ([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}"),
+				),
+			},
+
+			"principal_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ssoadmin.PrincipalType_Values(), false),
+			},
+
+			"target_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+
+			"target_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ssoadmin.TargetTypeAwsAccount,
+				ValidateFunc: validation.StringInSlice(ssoadmin.TargetType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsSsoAdminAccountAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	instanceArn := d.Get("instance_arn").(string)
+	permissionSetArn := d.Get("permission_set_arn").(string)
+	principalID := d.Get("principal_id").(string)
+	principalType := d.Get("principal_type").(string)
+	targetID := d.Get("target_id").(string)
+	targetType := d.Get("target_type").(string)
+	id := tfssoadmin.AccountAssignmentCreateResourceID(principalID, principalType, targetID, targetType, permissionSetArn, instanceArn)
+
+	input := &ssoadmin.CreateAccountAssignmentInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+		PrincipalId:      aws.String(principalID),
+		PrincipalType:    aws.String(principalType),
+		TargetId:         aws.String(targetID),
+		TargetType:       aws.String(targetType),
+	}
+
+	log.Printf("[DEBUG] Creating SSO Account Assignment: %s", input)
+	output, err := conn.CreateAccountAssignment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SSO Account Assignment (%s): %w", id, err)
+	}
+
+	if output == nil || output.AccountAssignmentCreationStatus == nil {
+		return fmt.Errorf("error creating SSO Account Assignment (%s): empty output", id)
+	}
+
+	d.SetId(id)
+
+	requestID := aws.StringValue(output.AccountAssignmentCreationStatus.RequestId)
+
+	if _, err := waiter.AccountAssignmentCreated(conn, instanceArn, requestID); err != nil {
+		return fmt.Errorf("error waiting for SSO Account Assignment (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsSsoAdminAccountAssignmentRead(d, meta)
+}
+
+func resourceAwsSsoAdminAccountAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	principalID, principalType, targetID, targetType, permissionSetArn, instanceArn, err := tfssoadmin.AccountAssignmentParseResourceID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Account Assignment ID: %w", err)
+	}
+
+	accountAssignment, err := finder.AccountAssignment(conn, principalID, principalType, targetID, permissionSetArn, instanceArn)
+
+	if !d.IsNewResource() && isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] SSO Account Assignment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Account Assignment (%s): %w", d.Id(), err)
+	}
+
+	if accountAssignment == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading SSO Account Assignment (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] SSO Account Assignment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_arn", instanceArn)
+	d.Set("permission_set_arn", accountAssignment.PermissionSetArn)
+	d.Set("principal_id", accountAssignment.PrincipalId)
+	d.Set("principal_type", accountAssignment.PrincipalType)
+	d.Set("target_id", accountAssignment.AccountId)
+	d.Set("target_type", targetType)
+
+	return nil
+}
+
+func resourceAwsSsoAdminAccountAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	principalID, principalType, targetID, targetType, permissionSetArn, instanceArn, err := tfssoadmin.AccountAssignmentParseResourceID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Account Assignment ID: %w", err)
+	}
+
+	log.Printf("[DEBUG] Deleting SSO Account Assignment (%s)", d.Id())
+	output, err := conn.DeleteAccountAssignment(&ssoadmin.DeleteAccountAssignmentInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+		PrincipalId:      aws.String(principalID),
+		PrincipalType:    aws.String(principalType),
+		TargetId:         aws.String(targetID),
+		TargetType:       aws.String(targetType),
+	})
+
+	if isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SSO Account Assignment (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.AccountAssignmentDeletionStatus == nil {
+		return fmt.Errorf("error deleting SSO Account Assignment (%s): empty output", d.Id())
+	}
+
+	requestID := aws.StringValue(output.AccountAssignmentDeletionStatus.RequestId)
+
+	if _, err := waiter.AccountAssignmentDeleted(conn, instanceArn, requestID); err != nil {
+		return fmt.Errorf("error waiting for SSO Account Assignment (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
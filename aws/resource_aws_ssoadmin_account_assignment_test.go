@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfssoadmin "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin/finder"
+)
+
+func TestAccAWSSSOAdminAccountAssignment_Basic_Group(t *testing.T) {
+	resourceName := "aws_ssoadmin_account_assignment.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreGroupName(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSOAdminAccountAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSOAdminAccountAssignmentBasicGroupConfig(groupName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminAccountAssignmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_set_arn", "aws_ssoadmin_permission_set.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_id", "data.aws_identitystore_group.test", "group_id"),
+					resource.TestCheckResourceAttr(resourceName, "principal_type", ssoadmin.PrincipalTypeGroup),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "target_type", ssoadmin.TargetTypeAwsAccount),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSSOAdminAccountAssignment_Basic_User(t *testing.T) {
+	resourceName := "aws_ssoadmin_account_assignment.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	userName := os.Getenv("AWS_IDENTITY_STORE_USER_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreUserName(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSOAdminAccountAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSOAdminAccountAssignmentBasicUserConfig(userName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminAccountAssignmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_id", "data.aws_identitystore_user.test", "user_id"),
+					resource.TestCheckResourceAttr(resourceName, "principal_type", ssoadmin.PrincipalTypeUser),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSSOAdminAccountAssignment_disappears(t *testing.T) {
+	resourceName := "aws_ssoadmin_account_assignment.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	groupName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSSOAdminInstances(t)
+			testAccPreCheckAWSIdentityStoreGroupName(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSOAdminAccountAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSOAdminAccountAssignmentBasicGroupConfig(groupName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminAccountAssignmentExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSsoAdminAccountAssignment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSSOAdminAccountAssignmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_account_assignment" {
+			continue
+		}
+
+		principalID, principalType, targetID, _, permissionSetArn, instanceArn, err := tfssoadmin.AccountAssignmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		accountAssignment, err := finder.AccountAssignment(conn, principalID, principalType, targetID, permissionSetArn, instanceArn)
+
+		if isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if accountAssignment != nil {
+			return fmt.Errorf("SSO Account Assignment (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSSOAdminAccountAssignmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSO Account Assignment ID is set")
+		}
+
+		principalID, principalType, targetID, _, permissionSetArn, instanceArn, err := tfssoadmin.AccountAssignmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+
+		accountAssignment, err := finder.AccountAssignment(conn, principalID, principalType, targetID, permissionSetArn, instanceArn)
+
+		if err != nil {
+			return err
+		}
+
+		if accountAssignment == nil {
+			return fmt.Errorf("SSO Account Assignment (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPreCheckAWSIdentityStoreGroupName(t *testing.T) {
+	if os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME") == "" {
+		t.Skip("AWS_IDENTITY_STORE_GROUP_NAME env var must be set for AWS Identity Store Group acceptance test. " +
+			"This is required until ListGroups API returns results without filtering by name.")
+	}
+}
+
+func testAccPreCheckAWSIdentityStoreUserName(t *testing.T) {
+	if os.Getenv("AWS_IDENTITY_STORE_USER_NAME") == "" {
+		t.Skip("AWS_IDENTITY_STORE_USER_NAME env var must be set for AWS Identity Store User acceptance test. " +
+			"This is required until ListUsers API returns results without filtering by name.")
+	}
+}
+
+func testAccAWSSSOAdminAccountAssignmentBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name         = %[1]q
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+`, rName)
+}
+
+func testAccAWSSSOAdminAccountAssignmentBasicGroupConfig(groupName, rName string) string {
+	return composeConfig(
+		testAccAWSSSOAdminAccountAssignmentBaseConfig(rName),
+		fmt.Sprintf(`
+data "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  filter {
+    attribute_path  = "DisplayName"
+    attribute_value = %[1]q
+  }
+}
+
+resource "aws_ssoadmin_account_assignment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  target_type        = "AWS_ACCOUNT"
+  target_id          = data.aws_caller_identity.current.account_id
+  principal_type     = "GROUP"
+  principal_id       = data.aws_identitystore_group.test.group_id
+}
+`, groupName))
+}
+
+func testAccAWSSSOAdminAccountAssignmentBasicUserConfig(userName, rName string) string {
+	return composeConfig(
+		testAccAWSSSOAdminAccountAssignmentBaseConfig(rName),
+		fmt.Sprintf(`
+data "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  filter {
+    attribute_path  = "UserName"
+    attribute_value = %[1]q
+  }
+}
+
+resource "aws_ssoadmin_account_assignment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  target_type        = "AWS_ACCOUNT"
+  target_id          = data.aws_caller_identity.current.account_id
+  principal_type     = "USER"
+  principal_id       = data.aws_identitystore_user.test.user_id
+}
+`, userName))
+}
@@ -0,0 +1,153 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfssoadmin "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin/finder"
+)
+
+func resourceAwsSsoAdminManagedPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSsoAdminManagedPolicyAttachmentCreate,
+		Read:   resourceAwsSsoAdminManagedPolicyAttachmentRead,
+		Delete: resourceAwsSsoAdminManagedPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"managed_policy_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"managed_policy_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"permission_set_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsSsoAdminManagedPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	instanceArn := d.Get("instance_arn").(string)
+	managedPolicyArn := d.Get("managed_policy_arn").(string)
+	permissionSetArn := d.Get("permission_set_arn").(string)
+	id := tfssoadmin.ManagedPolicyAttachmentCreateResourceID(managedPolicyArn, permissionSetArn, instanceArn)
+
+	input := &ssoadmin.AttachManagedPolicyToPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		ManagedPolicyArn: aws.String(managedPolicyArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	log.Printf("[DEBUG] Attaching Managed Policy to SSO Permission Set: %s", input)
+	_, err := conn.AttachManagedPolicyToPermissionSet(input)
+
+	if err != nil {
+		return fmt.Errorf("error attaching Managed Policy to SSO Permission Set (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	// Provision the Permission Set so that the policy reaches all accounts it is provisioned to.
+	if err := provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn); err != nil {
+		return err
+	}
+
+	return resourceAwsSsoAdminManagedPolicyAttachmentRead(d, meta)
+}
+
+func resourceAwsSsoAdminManagedPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	managedPolicyArn, permissionSetArn, instanceArn, err := tfssoadmin.ManagedPolicyAttachmentParseResourceID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Managed Policy Attachment ID: %w", err)
+	}
+
+	policy, err := finder.ManagedPolicy(conn, managedPolicyArn, permissionSetArn, instanceArn)
+
+	if !d.IsNewResource() && isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] SSO Managed Policy Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Managed Policy Attachment (%s): %w", d.Id(), err)
+	}
+
+	if policy == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading SSO Managed Policy Attachment (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] SSO Managed Policy Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_arn", instanceArn)
+	d.Set("managed_policy_arn", policy.Arn)
+	d.Set("managed_policy_name", policy.Name)
+	d.Set("permission_set_arn", permissionSetArn)
+
+	return nil
+}
+
+func resourceAwsSsoAdminManagedPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	managedPolicyArn, permissionSetArn, instanceArn, err := tfssoadmin.ManagedPolicyAttachmentParseResourceID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Managed Policy Attachment ID: %w", err)
+	}
+
+	log.Printf("[DEBUG] Detaching Managed Policy from SSO Permission Set (%s)", d.Id())
+	_, err = conn.DetachManagedPolicyFromPermissionSet(&ssoadmin.DetachManagedPolicyFromPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		ManagedPolicyArn: aws.String(managedPolicyArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	})
+
+	if isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error detaching Managed Policy from SSO Permission Set (%s): %w", d.Id(), err)
+	}
+
+	// Provision the Permission Set so that the policy is removed from all accounts it is provisioned to.
+	if err := provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,297 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfssoadmin "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin/waiter"
+)
+
+func resourceAwsSsoAdminPermissionSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSsoAdminPermissionSetCreate,
+		Read:   resourceAwsSsoAdminPermissionSetRead,
+		Update: resourceAwsSsoAdminPermissionSetUpdate,
+		Delete: resourceAwsSsoAdminPermissionSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 700),
+			},
+
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 32),
+					validation.StringMatch(regexp.MustCompile(`^[\w+=,.@-]+$`), "must match [\\w+=,.@-]"),
+				),
+			},
+
+			"relay_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 240),
+			},
+
+			"session_duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "PT1H",
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsSsoAdminPermissionSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	instanceArn := d.Get("instance_arn").(string)
+	name := d.Get("name").(string)
+
+	input := &ssoadmin.CreatePermissionSetInput{
+		InstanceArn: aws.String(instanceArn),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("relay_state"); ok {
+		input.RelayState = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("session_duration"); ok {
+		input.SessionDuration = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().SsoadminTags()
+	}
+
+	log.Printf("[DEBUG] Creating SSO Permission Set: %s", input)
+	output, err := conn.CreatePermissionSet(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SSO Permission Set (%s): %w", name, err)
+	}
+
+	if output == nil || output.PermissionSet == nil {
+		return fmt.Errorf("error creating SSO Permission Set (%s): empty output", name)
+	}
+
+	d.SetId(tfssoadmin.PermissionSetCreateResourceID(aws.StringValue(output.PermissionSet.PermissionSetArn), instanceArn))
+
+	return resourceAwsSsoAdminPermissionSetRead(d, meta)
+}
+
+func resourceAwsSsoAdminPermissionSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	permissionSetArn, instanceArn, err := tfssoadmin.PermissionSetParseResourceID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Permission Set ID: %w", err)
+	}
+
+	permissionSet, err := finder.PermissionSet(conn, permissionSetArn, instanceArn)
+
+	if !d.IsNewResource() && isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] SSO Permission Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Permission Set (%s): %w", d.Id(), err)
+	}
+
+	if permissionSet == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading SSO Permission Set (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] SSO Permission Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", permissionSet.PermissionSetArn)
+	d.Set("created_date", aws.TimeValue(permissionSet.CreatedDate).Format(time.RFC3339))
+	d.Set("description", permissionSet.Description)
+	d.Set("instance_arn", instanceArn)
+	d.Set("name", permissionSet.Name)
+	d.Set("relay_state", permissionSet.RelayState)
+	d.Set("session_duration", permissionSet.SessionDuration)
+
+	tags, err := keyvaluetags.SsoadminListTags(conn, permissionSetArn, instanceArn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SSO Permission Set (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSsoAdminPermissionSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	permissionSetArn, instanceArn, err := tfssoadmin.PermissionSetParseResourceID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Permission Set ID: %w", err)
+	}
+
+	if d.HasChanges("description", "relay_state", "session_duration") {
+		input := &ssoadmin.UpdatePermissionSetInput{
+			InstanceArn:      aws.String(instanceArn),
+			PermissionSetArn: aws.String(permissionSetArn),
+		}
+
+		// The AWS SSO API requires we send the RelayState value regardless if it's unchanged
+		// else the existing Permission Set's RelayState value will be cleared.
+		// Reference: https://github.com/aws/aws-sdk-go/issues/3604
+		if v, ok := d.GetOk("relay_state"); ok {
+			input.RelayState = aws.String(v.(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("session_duration") {
+			input.SessionDuration = aws.String(d.Get("session_duration").(string))
+		}
+
+		log.Printf("[DEBUG] Updating SSO Permission Set: %s", input)
+		_, err := conn.UpdatePermissionSet(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SSO Permission Set (%s): %w", d.Id(), err)
+		}
+
+		// Re-provision the Permission Set so that changes reach all accounts it is provisioned to.
+		if err := provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.SsoadminUpdateTags(conn, permissionSetArn, instanceArn, o, n); err != nil {
+			return fmt.Errorf("error updating SSO Permission Set (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSsoAdminPermissionSetRead(d, meta)
+}
+
+func resourceAwsSsoAdminPermissionSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	permissionSetArn, instanceArn, err := tfssoadmin.PermissionSetParseResourceID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Permission Set ID: %w", err)
+	}
+
+	log.Printf("[DEBUG] Deleting SSO Permission Set (%s)", d.Id())
+	_, err = conn.DeletePermissionSet(&ssoadmin.DeletePermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	})
+
+	if isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SSO Permission Set (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// provisionSsoAdminPermissionSet provisions a Permission Set to all accounts it is
+// already provisioned to and waits for the provisioning request to complete.
+func provisionSsoAdminPermissionSet(conn *ssoadmin.SSOAdmin, permissionSetArn, instanceArn string) error {
+	input := &ssoadmin.ProvisionPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+		TargetType:       aws.String(ssoadmin.ProvisionTargetTypeAllProvisionedAccounts),
+	}
+
+	log.Printf("[DEBUG] Provisioning SSO Permission Set: %s", input)
+	output, err := conn.ProvisionPermissionSet(input)
+
+	if err != nil {
+		return fmt.Errorf("error provisioning SSO Permission Set (%s): %w", permissionSetArn, err)
+	}
+
+	if output == nil || output.PermissionSetProvisioningStatus == nil {
+		return fmt.Errorf("error provisioning SSO Permission Set (%s): empty output", permissionSetArn)
+	}
+
+	requestID := aws.StringValue(output.PermissionSetProvisioningStatus.RequestId)
+
+	if _, err := waiter.PermissionSetProvisioned(conn, instanceArn, requestID); err != nil {
+		return fmt.Errorf("error waiting for SSO Permission Set (%s) provisioning: %w", permissionSetArn, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfssoadmin "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin/finder"
+)
+
+func resourceAwsSsoAdminPermissionSetInlinePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSsoAdminPermissionSetInlinePolicyPut,
+		Read:   resourceAwsSsoAdminPermissionSetInlinePolicyRead,
+		Update: resourceAwsSsoAdminPermissionSetInlinePolicyPut,
+		Delete: resourceAwsSsoAdminPermissionSetInlinePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"inline_policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateIAMPolicyJson,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"permission_set_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsSsoAdminPermissionSetInlinePolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	instanceArn := d.Get("instance_arn").(string)
+	permissionSetArn := d.Get("permission_set_arn").(string)
+	id := tfssoadmin.PermissionSetCreateResourceID(permissionSetArn, instanceArn)
+
+	input := &ssoadmin.PutInlinePolicyToPermissionSetInput{
+		InlinePolicy:     aws.String(d.Get("inline_policy").(string)),
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	log.Printf("[DEBUG] Putting SSO Permission Set Inline Policy: %s", input)
+	_, err := conn.PutInlinePolicyToPermissionSet(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting SSO Permission Set Inline Policy (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	// Provision the Permission Set so that the policy reaches all accounts it is provisioned to.
+	if err := provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn); err != nil {
+		return err
+	}
+
+	return resourceAwsSsoAdminPermissionSetInlinePolicyRead(d, meta)
+}
+
+func resourceAwsSsoAdminPermissionSetInlinePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	permissionSetArn, instanceArn, err := tfssoadmin.PermissionSetParseResourceID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Permission Set Inline Policy ID: %w", err)
+	}
+
+	policy, err := finder.InlinePolicy(conn, permissionSetArn, instanceArn)
+
+	if !d.IsNewResource() && isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] SSO Permission Set Inline Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Permission Set Inline Policy (%s): %w", d.Id(), err)
+	}
+
+	if policy == "" {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading SSO Permission Set Inline Policy (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] SSO Permission Set Inline Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("inline_policy", policy)
+	d.Set("instance_arn", instanceArn)
+	d.Set("permission_set_arn", permissionSetArn)
+
+	return nil
+}
+
+func resourceAwsSsoAdminPermissionSetInlinePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssoadminconn
+
+	permissionSetArn, instanceArn, err := tfssoadmin.PermissionSetParseResourceID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Permission Set Inline Policy ID: %w", err)
+	}
+
+	log.Printf("[DEBUG] Deleting SSO Permission Set Inline Policy (%s)", d.Id())
+	_, err = conn.DeleteInlinePolicyFromPermissionSet(&ssoadmin.DeleteInlinePolicyFromPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	})
+
+	if isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SSO Permission Set Inline Policy (%s): %w", d.Id(), err)
+	}
+
+	// Provision the Permission Set so that the policy is removed from all accounts it is provisioned to.
+	if err := provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn); err != nil {
+		return err
+	}
+
+	return nil
+}
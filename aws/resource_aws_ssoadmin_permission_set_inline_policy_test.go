@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfssoadmin "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ssoadmin/finder"
+)
+
+func TestAccAWSSSOAdminPermissionSetInlinePolicy_basic(t *testing.T) {
+	resourceName := "aws_ssoadmin_permission_set_inline_policy.test"
+	permissionSetResourceName := "aws_ssoadmin_permission_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSSOAdminInstances(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSOAdminPermissionSetInlinePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSOAdminPermissionSetInlinePolicyConfig(rName, "s3:ListAllMyBuckets"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminPermissionSetInlinePolicyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_arn", permissionSetResourceName, "instance_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_set_arn", permissionSetResourceName, "arn"),
+					resource.TestMatchResourceAttr(resourceName, "inline_policy", regexp.MustCompile("s3:ListAllMyBuckets")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSSOAdminPermissionSetInlinePolicyConfig(rName, "s3:ListBucket"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminPermissionSetInlinePolicyExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "inline_policy", regexp.MustCompile("s3:ListBucket")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSSOAdminPermissionSetInlinePolicy_disappears(t *testing.T) {
+	resourceName := "aws_ssoadmin_permission_set_inline_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSSOAdminInstances(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSSOAdminPermissionSetInlinePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSSOAdminPermissionSetInlinePolicyConfig(rName, "s3:ListAllMyBuckets"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSSOAdminPermissionSetInlinePolicyExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSsoAdminPermissionSetInlinePolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSSOAdminPermissionSetInlinePolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_permission_set_inline_policy" {
+			continue
+		}
+
+		permissionSetArn, instanceArn, err := tfssoadmin.PermissionSetParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		policy, err := finder.InlinePolicy(conn, permissionSetArn, instanceArn)
+
+		if isAWSErr(err, ssoadmin.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if policy != "" {
+			return fmt.Errorf("SSO Permission Set Inline Policy (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSSOAdminPermissionSetInlinePolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSO Permission Set Inline Policy ID is set")
+		}
+
+		permissionSetArn, instanceArn, err := tfssoadmin.PermissionSetParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+
+		policy, err := finder.InlinePolicy(conn, permissionSetArn, instanceArn)
+
+		if err != nil {
+			return err
+		}
+
+		if policy == "" {
+			return fmt.Errorf("SSO Permission Set Inline Policy (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSSSOAdminPermissionSetInlinePolicyConfig(rName, action string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    sid = "1"
+
+    actions = [
+      %[2]q,
+    ]
+
+    resources = [
+      "arn:${data.aws_partition.current.partition}:s3:::*",
+    ]
+  }
+}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name         = %[1]q
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+resource "aws_ssoadmin_permission_set_inline_policy" "test" {
+  inline_policy      = data.aws_iam_policy_document.test.json
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+}
+`, rName, action)
+}
@@ -0,0 +1,717 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/synthetics/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_synthetics_canary", &resource.Sweeper{
+		Name: "aws_synthetics_canary",
+		F:    testSweepSyntheticsCanaries,
+		Dependencies: []string{
+			"aws_lambda_function",
+			"aws_lambda_layer",
+		},
+	})
+}
+
+func testSweepSyntheticsCanaries(region string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).syntheticsconn
+	input := &synthetics.DescribeCanariesInput{}
+	var sweeperErrs *multierror.Error
+
+	err = conn.DescribeCanariesPages(input, func(page *synthetics.DescribeCanariesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, canary := range page.Canaries {
+			name := aws.StringValue(canary.Name)
+			r := resourceAwsSyntheticsCanary()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			if canary.Status != nil {
+				d.Set("status", canary.Status.State)
+			}
+
+			log.Printf("[INFO] Deleting Synthetics Canary: %s", name)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting Synthetics Canary (%s): %w", name, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Synthetics Canary sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Synthetics Canaries: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSSyntheticsCanary_basic(t *testing.T) {
+	var conf synthetics.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSynthetics(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName, &conf),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "synthetics", regexp.MustCompile(`canary:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "runtime_version", "syn-nodejs-2.0"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.memory_in_mb", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.timeout_in_seconds", "840"),
+					resource.TestCheckResourceAttr(resourceName, "failure_retention_period", "31"),
+					resource.TestCheckResourceAttr(resourceName, "success_retention_period", "31"),
+					resource.TestCheckResourceAttr(resourceName, "handler", "exports.handler"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.duration_in_seconds", "0"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.expression", "rate(0 hour)"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "timeline.0.created"),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "artifact_s3_location", fmt.Sprintf("%s/", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "engine_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_location_arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "READY"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary"},
+			},
+			{
+				Config: testAccAWSSyntheticsCanaryZipUpdatedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "handler", "exports.handler"),
+					resource.TestCheckResourceAttr(resourceName, "status", "READY"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_disappears(t *testing.T) {
+	var conf synthetics.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSynthetics(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName, &conf),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSyntheticsCanary(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_S3Code(t *testing.T) {
+	var conf synthetics.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSynthetics(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryBasicS3CodeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "handler", "exports.handler"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket", "aws_s3_bucket_object.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_key", "aws_s3_bucket_object.test", "key"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_version", "aws_s3_bucket_object.test", "version_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"s3_bucket", "s3_key", "s3_version", "start_canary"},
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_RunConfig(t *testing.T) {
+	var conf synthetics.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSynthetics(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryRunConfigConfig(rName, 60, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "run_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.active_tracing", "false"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.timeout_in_seconds", "60"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary"},
+			},
+			{
+				Config: testAccAWSSyntheticsCanaryRunConfigConfig(rName, 120, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "run_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.active_tracing", "true"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.timeout_in_seconds", "120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_StartCanary(t *testing.T) {
+	var conf1, conf2, conf3 synthetics.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSynthetics(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryStartCanaryConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "start_canary", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+					resource.TestCheckResourceAttrSet(resourceName, "timeline.0.last_started"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary"},
+			},
+			{
+				Config: testAccAWSSyntheticsCanaryStartCanaryConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "start_canary", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "STOPPED"),
+					resource.TestCheckResourceAttrSet(resourceName, "timeline.0.last_stopped"),
+				),
+			},
+			{
+				Config: testAccAWSSyntheticsCanaryStartCanaryConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName, &conf3),
+					resource.TestCheckResourceAttr(resourceName, "start_canary", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_Vpc(t *testing.T) {
+	var conf synthetics.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSynthetics(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryVPCConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.security_group_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_config.0.vpc_id", "aws_vpc.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary"},
+			},
+		},
+	})
+}
+
+func TestAccAWSSyntheticsCanary_Tags(t *testing.T) {
+	var conf synthetics.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSSynthetics(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSyntheticsCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSyntheticsCanaryConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary"},
+			},
+			{
+				Config: testAccAWSSyntheticsCanaryConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSSyntheticsCanaryConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSyntheticsCanaryExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSSynthetics(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).syntheticsconn
+
+	input := &synthetics.DescribeCanariesInput{}
+
+	_, err := conn.DescribeCanaries(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAwsSyntheticsCanaryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).syntheticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_synthetics_canary" {
+			continue
+		}
+
+		_, err := finder.CanaryByName(conn, rs.Primary.ID)
+
+		if isAWSErr(err, synthetics.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Synthetics Canary %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSyntheticsCanaryExists(resourceName string, canary *synthetics.Canary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Synthetics Canary ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).syntheticsconn
+
+		output, err := finder.CanaryByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Synthetics Canary (%s) not found", rs.Primary.ID)
+		}
+
+		*canary = *output
+
+		return nil
+	}
+}
+
+func testAccAWSSyntheticsCanaryConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  acl           = "private"
+  force_destroy = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_policy" "test" {
+  name = %[1]q
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:PutObject",
+        "s3:GetBucketLocation",
+        "s3:ListAllMyBuckets",
+        "cloudwatch:PutMetricData",
+        "logs:CreateLogGroup",
+        "logs:CreateLogStream",
+        "logs:PutLogEvents",
+        "xray:PutTraceSegments"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = aws_iam_policy.test.arn
+}
+`, rName)
+}
+
+func testAccAWSSyntheticsCanaryBasicConfig(rName string) string {
+	return composeConfig(
+		testAccAWSSyntheticsCanaryConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.id}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/synthetics_canary.zip"
+  runtime_version      = "syn-nodejs-2.0"
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccAWSSyntheticsCanaryZipUpdatedConfig(rName string) string {
+	return composeConfig(
+		testAccAWSSyntheticsCanaryConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.id}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/synthetics_canary_modified.zip"
+  runtime_version      = "syn-nodejs-2.0"
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccAWSSyntheticsCanaryBasicS3CodeConfig(rName string) string {
+	return composeConfig(
+		testAccAWSSyntheticsCanaryConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "code" {
+  bucket        = "%[1]s-code"
+  force_destroy = true
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = aws_s3_bucket.code.id
+  key    = %[1]q
+  source = "test-fixtures/synthetics_canary.zip"
+  etag   = filemd5("test-fixtures/synthetics_canary.zip")
+}
+
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.id}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  s3_bucket            = aws_s3_bucket_object.test.bucket
+  s3_key               = aws_s3_bucket_object.test.key
+  s3_version           = aws_s3_bucket_object.test.version_id
+  runtime_version      = "syn-nodejs-2.0"
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccAWSSyntheticsCanaryRunConfigConfig(rName string, timeout int, activeTracing bool) string {
+	return composeConfig(
+		testAccAWSSyntheticsCanaryConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.id}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/synthetics_canary.zip"
+  runtime_version      = "syn-nodejs-2.0"
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  run_config {
+    active_tracing     = %[3]t
+    timeout_in_seconds = %[2]d
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, timeout, activeTracing))
+}
+
+func testAccAWSSyntheticsCanaryStartCanaryConfig(rName string, state bool) string {
+	return composeConfig(
+		testAccAWSSyntheticsCanaryConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.id}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/synthetics_canary.zip"
+  runtime_version      = "syn-nodejs-2.0"
+  start_canary         = %[2]t
+
+  schedule {
+    expression = "rate(5 minutes)"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, state))
+}
+
+func testAccAWSSyntheticsCanaryVPCConfig(rName string) string {
+	return composeConfig(
+		testAccAWSSyntheticsCanaryConfigBase(rName),
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.1.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_iam_role_policy_attachment" "vpc" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSLambdaVPCAccessExecutionRole"
+  role       = aws_iam_role.test.name
+}
+
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.id}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/synthetics_canary.zip"
+  runtime_version      = "syn-nodejs-2.0"
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  vpc_config {
+    subnet_ids         = [aws_subnet.test.id]
+    security_group_ids = [aws_security_group.test.id]
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test, aws_iam_role_policy_attachment.vpc]
+}
+`, rName))
+}
+
+func testAccAWSSyntheticsCanaryConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSSyntheticsCanaryConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.id}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/synthetics_canary.zip"
+  runtime_version      = "syn-nodejs-2.0"
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSSyntheticsCanaryConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSSyntheticsCanaryConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.id}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/synthetics_canary.zip"
+  runtime_version      = "syn-nodejs-2.0"
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
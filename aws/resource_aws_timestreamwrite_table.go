@@ -0,0 +1,274 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tftimestreamwrite "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/timestreamwrite"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/timestreamwrite/finder"
+)
+
+func resourceAwsTimestreamWriteTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsTimestreamWriteTableCreate,
+		Read:   resourceAwsTimestreamWriteTableRead,
+		Update: resourceAwsTimestreamWriteTableUpdate,
+		Delete: resourceAwsTimestreamWriteTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"retention_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"magnetic_store_retention_period_in_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 73000),
+						},
+						"memory_store_retention_period_in_hours": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 8766),
+						},
+					},
+				},
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsTimestreamWriteTableCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
+	tableName := d.Get("table_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	input := &timestreamwrite.CreateTableInput{
+		DatabaseName: aws.String(databaseName),
+		TableName:    aws.String(tableName),
+	}
+
+	if v, ok := d.GetOk("retention_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetentionProperties = expandTimestreamWriteRetentionProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().TimestreamwriteTags()
+	}
+
+	log.Printf("[DEBUG] Creating Timestream Table: %s", input)
+	output, err := conn.CreateTable(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Timestream Table (%s) in Database (%s): %w", tableName, databaseName, err)
+	}
+
+	if output == nil || output.Table == nil {
+		return fmt.Errorf("error creating Timestream Table (%s) in Database (%s): empty response", tableName, databaseName)
+	}
+
+	d.SetId(tftimestreamwrite.TableCreateResourceID(aws.StringValue(output.Table.TableName), aws.StringValue(output.Table.DatabaseName)))
+
+	return resourceAwsTimestreamWriteTableRead(d, meta)
+}
+
+func resourceAwsTimestreamWriteTableRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	tableName, databaseName, err := tftimestreamwrite.TableParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	table, err := finder.TableByNameAndDatabaseName(conn, tableName, databaseName)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, timestreamwrite.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Timestream Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Timestream Table (%s): %w", d.Id(), err)
+	}
+
+	if table == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Timestream Table (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Timestream Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(table.Arn)
+
+	d.Set("arn", arn)
+	d.Set("database_name", table.DatabaseName)
+
+	if err := d.Set("retention_properties", flattenTimestreamWriteRetentionProperties(table.RetentionProperties)); err != nil {
+		return fmt.Errorf("error setting retention_properties: %w", err)
+	}
+
+	d.Set("table_name", table.TableName)
+
+	tags, err := keyvaluetags.TimestreamwriteListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Timestream Table (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsTimestreamWriteTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	if d.HasChange("retention_properties") {
+		tableName, databaseName, err := tftimestreamwrite.TableParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &timestreamwrite.UpdateTableInput{
+			DatabaseName: aws.String(databaseName),
+			TableName:    aws.String(tableName),
+		}
+
+		if v, ok := d.GetOk("retention_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.RetentionProperties = expandTimestreamWriteRetentionProperties(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err = conn.UpdateTable(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Timestream Table (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.TimestreamwriteUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Timestream Table (%s) tags: %w", d.Get("arn").(string), err)
+		}
+	}
+
+	return resourceAwsTimestreamWriteTableRead(d, meta)
+}
+
+func resourceAwsTimestreamWriteTableDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).timestreamwriteconn
+
+	tableName, databaseName, err := tftimestreamwrite.TableParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &timestreamwrite.DeleteTableInput{
+		DatabaseName: aws.String(databaseName),
+		TableName:    aws.String(tableName),
+	}
+
+	log.Printf("[DEBUG] Deleting Timestream Table: %s", d.Id())
+	_, err = conn.DeleteTable(input)
+
+	if tfawserr.ErrCodeEquals(err, timestreamwrite.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Timestream Table (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandTimestreamWriteRetentionProperties(tfMap map[string]interface{}) *timestreamwrite.RetentionProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &timestreamwrite.RetentionProperties{}
+
+	if v, ok := tfMap["magnetic_store_retention_period_in_days"].(int); ok {
+		apiObject.MagneticStoreRetentionPeriodInDays = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["memory_store_retention_period_in_hours"].(int); ok {
+		apiObject.MemoryStoreRetentionPeriodInHours = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenTimestreamWriteRetentionProperties(apiObject *timestreamwrite.RetentionProperties) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"magnetic_store_retention_period_in_days": aws.Int64Value(apiObject.MagneticStoreRetentionPeriodInDays),
+		"memory_store_retention_period_in_hours":  aws.Int64Value(apiObject.MemoryStoreRetentionPeriodInHours),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -1,6 +1,10 @@
 package aws
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,6 +30,16 @@ func tagsSchemaComputed() *schema.Schema {
 	}
 }
 
+// tagsSchemaTrulyComputed returns the schema to use for the "tags_all" attribute,
+// which holds the merger of resource and provider-level default tags.
+func tagsSchemaTrulyComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
 func tagsSchemaForceNew() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeMap,
@@ -53,6 +67,20 @@ func ec2TagsFromTagDescriptions(tds []*ec2.TagDescription) []*ec2.Tag {
 	return tags
 }
 
+// ec2TagSpecificationsFromKeyValueTags returns the tag specifications for the given KeyValueTags object and resource type.
+func ec2TagSpecificationsFromKeyValueTags(tags keyvaluetags.KeyValueTags, t string) []*ec2.TagSpecification {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String(t),
+			Tags:         tags.IgnoreAws().Ec2Tags(),
+		},
+	}
+}
+
 // ec2TagSpecificationsFromMap returns the tag specifications for the given tag key/value map and resource type.
 func ec2TagSpecificationsFromMap(m map[string]interface{}, t string) []*ec2.TagSpecification {
 	if len(m) == 0 {
@@ -66,3 +94,104 @@ func ec2TagSpecificationsFromMap(m map[string]interface{}, t string) []*ec2.TagS
 		},
 	}
 }
+
+// SetTagsDiff sets the new plan difference with the result of
+// merging resource tags on to those defined at the provider-level;
+// returns an error if unsuccessful or if the resource tags are identical
+// to those configured at the provider-level to avoid non-empty plans
+// after resource READ operations as resource and provider-level tags
+// will be indistinguishable when returned from an AWS API.
+func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	resourceTags := keyvaluetags.New(diff.Get("tags").(map[string]interface{}))
+
+	if defaultTagsConfig.TagsEqual(resourceTags) {
+		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
+	}
+
+	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+
+	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
+	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
+	// otherwise we mark the attribute as "Computed" only when there is a known diff
+	// (excluding an empty map) or a change for "tags_all".
+	if len(allTags) > 0 {
+		if err := diff.SetNew("tags_all", allTags.Map()); err != nil {
+			return fmt.Errorf("error setting new tags_all diff: %w", err)
+		}
+	} else if len(diff.Get("tags_all").(map[string]interface{})) > 0 {
+		if err := diff.SetNewComputed("tags_all"); err != nil {
+			return fmt.Errorf("error setting tags_all to computed: %w", err)
+		}
+	} else if diff.HasChange("tags_all") {
+		if err := diff.SetNewComputed("tags_all"); err != nil {
+			return fmt.Errorf("error setting tags_all to computed: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// RejectDefaultTagsDiff returns a CustomizeDiffFunc that returns an error
+// if tags are defined at the provider-level, for resources that do not yet
+// support the "tags_all" attribute and would otherwise silently not apply them.
+func RejectDefaultTagsDiff(resourceType string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, _ *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*AWSClient)
+
+		if !ok || client.DefaultTagsConfig == nil || len(client.DefaultTagsConfig.Tags) == 0 {
+			return nil
+		}
+
+		return fmt.Errorf("%s does not support the provider default_tags configuration: configure its tags with the resource \"tags\" argument using a provider configuration without default_tags", resourceType)
+	}
+}
+
+// ValidateRequiredTagsDiff returns a CustomizeDiffFunc that returns an error
+// if the merger of resource tags on to those defined at the provider-level
+// is missing any tag keys or has any tag values not allowed by the
+// provider-level required tags configuration. Provider-level default tags
+// are only merged for resources supporting the "tags_all" attribute, as no
+// other resource applies them.
+//
+// The resource address is not available to providers, so the error names the
+// resource type and Terraform reports the address of the resource being planned.
+func ValidateRequiredTagsDiff(resourceType string, defaultTags bool) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*AWSClient)
+
+		if !ok || client.RequiredTagsConfig == nil {
+			return nil
+		}
+
+		// Tags are validated once known, at the latest during apply.
+		if !diff.NewValueKnown("tags") {
+			return nil
+		}
+
+		requiredTagsConfig := client.RequiredTagsConfig
+		tags := keyvaluetags.New(diff.Get("tags").(map[string]interface{}))
+
+		if defaultTags {
+			tags = client.DefaultTagsConfig.MergeTags(tags)
+		}
+
+		var problems []string
+
+		if keys := requiredTagsConfig.MissingKeys(tags); len(keys) > 0 {
+			problems = append(problems, fmt.Sprintf("missing tag keys (%s)", strings.Join(keys, ", ")))
+		}
+
+		for _, key := range requiredTagsConfig.InvalidKeys(tags) {
+			problems = append(problems, fmt.Sprintf("tag %q value %q is not one of (%s)", key, aws.StringValue(tags.KeyValue(key)), strings.Join(requiredTagsConfig.AllowedValues[key], ", ")))
+		}
+
+		if len(problems) == 0 {
+			return nil
+		}
+
+		return fmt.Errorf("%s does not satisfy the provider required_tags configuration: %s", resourceType, strings.Join(problems, "; "))
+	}
+}
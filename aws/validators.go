@@ -32,6 +32,27 @@ var awsAccountIDRegexp = regexp.MustCompile(awsAccountIDRegexpPattern)
 var awsPartitionRegexp = regexp.MustCompile(awsPartitionRegexpPattern)
 var awsRegionRegexp = regexp.MustCompile(awsRegionRegexpPattern)
 
+// validateDuration validates a Go duration string, e.g. "30s", that is not negative.
+func validateDuration(v interface{}, k string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		es = append(es, fmt.Errorf("expected %s to be a duration such as \"30s\" or \"1m\", got %s", k, value))
+		return
+	}
+
+	if duration < 0 {
+		es = append(es, fmt.Errorf("expected %s to not be negative, got %s", k, value))
+	}
+
+	return
+}
+
 // validateTypeStringNullableBoolean provides custom error messaging for TypeString booleans
 // Some arguments require three values: true, false, and "" (unspecified).
 // This ValidateFunc returns a custom message since the message with
@@ -2522,3 +2543,31 @@ func MapKeysDoNotMatch(r *regexp.Regexp, message string) schema.SchemaValidateFu
 		return warnings, errors
 	}
 }
+
+// validateLakeFormationPrincipal validates a Lake Formation principal, which
+// can be the special IAM_ALLOWED_PRINCIPALS group, an AWS account ID or an ARN.
+func validateLakeFormationPrincipal(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "IAM_ALLOWED_PRINCIPALS" {
+		return ws, errors
+	}
+
+	if regexp.MustCompile(`^\d{12}$`).MatchString(value) {
+		return ws, errors
+	}
+
+	return validateArn(v, k)
+}
+
+// validateImageBuilderSemanticVersion validates an Image Builder semantic
+// version of the form MAJOR.MINOR.PATCH.
+func validateImageBuilderSemanticVersion(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !regexp.MustCompile(`^\d+\.\d+\.\d+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be a semantic version of the form MAJOR.MINOR.PATCH: %q", k, value))
+	}
+
+	return ws, errors
+}
@@ -10,6 +10,55 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 )
 
+func TestValidateDuration(t *testing.T) {
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val: "30s",
+		},
+		{
+			val: "1m30s",
+		},
+		{
+			val:         "30",
+			expectedErr: regexp.MustCompile(`to be a duration`),
+		},
+		{
+			val:         "-1s",
+			expectedErr: regexp.MustCompile(`to not be negative`),
+		},
+	}
+
+	matchErr := func(errs []error, r *regexp.Regexp) bool {
+		// err must match one provided
+		for _, err := range errs {
+			if r.MatchString(err.Error()) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for i, tc := range testCases {
+		_, errs := validateDuration(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if !matchErr(errs, tc.expectedErr) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
+
 func TestValidateTypeStringNullableBoolean(t *testing.T) {
 	testCases := []struct {
 		val         interface{}
@@ -3221,3 +3270,59 @@ func TestValidateServiceDiscoveryNamespaceName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateLakeFormationPrincipal(t *testing.T) {
+	validNames := []string{
+		"IAM_ALLOWED_PRINCIPALS",
+		"123456789012",
+		"arn:aws:iam::123456789012:role/example",
+		"arn:aws:iam::123456789012:user/example",
+		"arn:aws:iam::123456789012:saml-provider/example:group/example",
+	}
+	for _, v := range validNames {
+		_, errors := validateLakeFormationPrincipal(v, "principal")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Lake Formation principal: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"IAMAllowedPrincipals",
+		"12345678901",
+		"example",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateLakeFormationPrincipal(v, "principal")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Lake Formation principal", v)
+		}
+	}
+}
+
+func TestValidateImageBuilderSemanticVersion(t *testing.T) {
+	validVersions := []string{
+		"0.0.1",
+		"1.0.0",
+		"2020.11.26",
+	}
+	for _, v := range validVersions {
+		_, errors := validateImageBuilderSemanticVersion(v, "version")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Image Builder semantic version: %q", v, errors)
+		}
+	}
+
+	invalidVersions := []string{
+		"1",
+		"1.0",
+		"x.x.x",
+		"1.0.0/1",
+		"v1.0.0",
+	}
+	for _, v := range invalidVersions {
+		_, errors := validateImageBuilderSemanticVersion(v, "version")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Image Builder semantic version", v)
+		}
+	}
+}